@@ -0,0 +1,411 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/dorkitude/linctl/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// apiCmd represents the api command
+var apiCmd = &cobra.Command{
+	Use:   "api",
+	Short: "Low-level access to the Linear API",
+	Long: `Inspect and call the Linear GraphQL API directly.
+
+Examples:
+  linctl api rate-limit          # Show current rate limit and complexity budget
+  linctl api graphql '{ viewer { id name } }'
+  linctl api graphql -F id=ENG-123 'query($id: String!) { issue(id: $id) { title } }'`,
+}
+
+var apiGraphQLCmd = &cobra.Command{
+	Use:   "graphql [query]",
+	Short: "Run a raw GraphQL query",
+	Long: `Run a GraphQL query or mutation against Linear using the stored credentials
+and print the resulting data object as JSON.
+
+The query is taken from the argument, from --file (use '-' for stdin), or
+from stdin when neither is given.
+
+Variables:
+  -F key=value          Typed variable: true/false/null, numbers and JSON
+                        objects/arrays are decoded, anything else is a string.
+                        Use key=@path to read the value from a file.
+  --variables-file FILE JSON object of variables (-F values take precedence)
+
+Pagination:
+  --paginate follows the first connection in the result that has pageInfo
+  and nodes. The query must accept an $after: String variable.
+
+Selection:
+  --jq selects values with a jq-style path such as '.issues.nodes[].title'.
+  Strings are printed raw, other values as JSON.
+
+Examples:
+  linctl api graphql '{ viewer { id name email } }'
+  linctl api graphql --file query.graphql -F teamKey=ENG
+  echo '{ teams { nodes { key } } }' | linctl api graphql --jq '.teams.nodes[].key'
+  linctl api graphql --paginate --jq '.issues.nodes[].identifier' \
+    'query($after: String) { issues(first: 100, after: $after) { nodes { identifier } pageInfo { hasNextPage endCursor } } }'`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		query, err := readGraphQLQuery(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		variables, err := buildGraphQLVariables(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		paginate, _ := cmd.Flags().GetBool("paginate")
+		if paginate && !strings.Contains(query, "$after") {
+			output.Error("--paginate requires the query to accept an $after: String variable", plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		var data interface{}
+		if paginate {
+			data, err = executeGraphQLPaginated(context.Background(), client, query, variables)
+		} else {
+			data, err = executeGraphQL(context.Background(), client, query, variables)
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("GraphQL request failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		jqExpr, _ := cmd.Flags().GetString("jq")
+		if jqExpr == "" {
+			output.JSON(data)
+			return
+		}
+
+		results, err := utils.SelectPath(data, jqExpr)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid --jq expression: %v", err), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		for _, result := range results {
+			switch v := result.(type) {
+			case string:
+				fmt.Println(v)
+			case nil:
+				fmt.Println("null")
+			case map[string]interface{}, []interface{}:
+				output.JSON(v)
+			default:
+				fmt.Println(v)
+			}
+		}
+	},
+}
+
+// readGraphQLQuery reads the query from the argument, --file, or stdin
+func readGraphQLQuery(cmd *cobra.Command, args []string) (string, error) {
+	file, _ := cmd.Flags().GetString("file")
+
+	var query string
+	switch {
+	case len(args) > 0 && file != "":
+		return "", fmt.Errorf("provide the query either as an argument or with --file, not both")
+	case len(args) > 0:
+		query = args[0]
+	case file != "":
+		data, err := readFileOrStdin(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query file: %v", err)
+		}
+		query = string(data)
+	default:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query from stdin: %v", err)
+		}
+		query = string(data)
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("a GraphQL query is required (argument, --file, or stdin)")
+	}
+	return query, nil
+}
+
+// readFileOrStdin reads a file, treating "-" as stdin
+func readFileOrStdin(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// buildGraphQLVariables merges --variables-file with -F key=value fields
+func buildGraphQLVariables(cmd *cobra.Command) (map[string]interface{}, error) {
+	variables := make(map[string]interface{})
+
+	if path, _ := cmd.Flags().GetString("variables-file"); path != "" {
+		data, err := readFileOrStdin(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read variables file: %v", err)
+		}
+		if err := json.Unmarshal(data, &variables); err != nil {
+			return nil, fmt.Errorf("variables file must contain a JSON object: %v", err)
+		}
+	}
+
+	fields, _ := cmd.Flags().GetStringArray("field")
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid field %q: expected key=value", field)
+		}
+		parsed, err := parseFieldValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %q: %v", field, err)
+		}
+		variables[key] = parsed
+	}
+
+	return variables, nil
+}
+
+// parseFieldValue converts a -F value into a typed GraphQL variable
+func parseFieldValue(value string) (interface{}, error) {
+	if strings.HasPrefix(value, "@") {
+		data, err := readFileOrStdin(value[1:])
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded, nil
+		}
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, nil
+	}
+	return value, nil
+}
+
+// executeGraphQL runs a query and decodes its data object
+func executeGraphQL(ctx context.Context, client *api.Client, query string, variables map[string]interface{}) (interface{}, error) {
+	var raw json.RawMessage
+	if err := client.Execute(ctx, query, variables, &raw); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode response data: %w", err)
+	}
+	return data, nil
+}
+
+// executeGraphQLPaginated follows the first paginated connection in the
+// result and returns the first page's data with every page's nodes merged in
+func executeGraphQLPaginated(ctx context.Context, client *api.Client, query string, variables map[string]interface{}) (interface{}, error) {
+	data, err := executeGraphQL(ctx, client, query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := findConnectionPath(data, nil)
+	if !ok {
+		return nil, fmt.Errorf("--paginate: no connection with nodes and pageInfo found in the result")
+	}
+	connection := lookupConnection(data, path)
+
+	for {
+		hasNext, _ := connection["pageInfo"].(map[string]interface{})["hasNextPage"].(bool)
+		cursor, _ := connection["pageInfo"].(map[string]interface{})["endCursor"].(string)
+		if !hasNext || cursor == "" || cursor == variables["after"] {
+			return data, nil
+		}
+
+		variables["after"] = cursor
+		page, err := executeGraphQL(ctx, client, query, variables)
+		if err != nil {
+			return nil, err
+		}
+		next := lookupConnection(page, path)
+		if next == nil {
+			return nil, fmt.Errorf("--paginate: connection missing from page after cursor %s", cursor)
+		}
+
+		nodes, _ := connection["nodes"].([]interface{})
+		more, _ := next["nodes"].([]interface{})
+		connection["nodes"] = append(nodes, more...)
+		connection["pageInfo"] = next["pageInfo"]
+	}
+}
+
+// findConnectionPath locates the first object with nodes and pageInfo
+func findConnectionPath(value interface{}, path []string) ([]string, bool) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	if _, hasNodes := obj["nodes"].([]interface{}); hasNodes {
+		if _, hasPageInfo := obj["pageInfo"].(map[string]interface{}); hasPageInfo {
+			return path, true
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if found, ok := findConnectionPath(obj[key], append(append([]string{}, path...), key)); ok {
+			return found, true
+		}
+	}
+	return nil, false
+}
+
+// lookupConnection returns the object at path, or nil if it is missing
+func lookupConnection(value interface{}, path []string) map[string]interface{} {
+	for _, key := range path {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = obj[key]
+	}
+	obj, _ := value.(map[string]interface{})
+	return obj
+}
+
+var apiRateLimitCmd = &cobra.Command{
+	Use:   "rate-limit",
+	Short: "Show API rate limit status",
+	Long:  `Show the request and complexity rate limits reported by Linear for the current credentials.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		rl, err := client.GetRateLimit(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get rate limit: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(rl)
+		} else if plaintext {
+			fmt.Printf("Requests: %d/%d remaining\n", rl.Remaining, rl.Limit)
+			fmt.Printf("Requests Reset: %s\n", formatReset(rl.Reset))
+			fmt.Printf("Complexity: %d/%d remaining\n", rl.ComplexityRemaining, rl.ComplexityLimit)
+			fmt.Printf("Complexity Reset: %s\n", formatReset(rl.ComplexityReset))
+			fmt.Printf("Last Query Complexity: %d\n", rl.Complexity)
+		} else {
+			fmt.Println()
+			fmt.Printf("%s\n", color.New(color.FgCyan, color.Bold).Sprint("⏱️  Linear API Rate Limits"))
+			fmt.Printf("\n%s %s remaining (resets %s)\n",
+				color.New(color.Bold).Sprint("Requests:"),
+				rateLimitColor(rl.Remaining, rl.Limit).Sprintf("%d/%d", rl.Remaining, rl.Limit),
+				formatReset(rl.Reset))
+			fmt.Printf("%s %s remaining (resets %s)\n",
+				color.New(color.Bold).Sprint("Complexity:"),
+				rateLimitColor(rl.ComplexityRemaining, rl.ComplexityLimit).Sprintf("%d/%d", rl.ComplexityRemaining, rl.ComplexityLimit),
+				formatReset(rl.ComplexityReset))
+			fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Last query complexity:"), rl.Complexity)
+			fmt.Println()
+		}
+	},
+}
+
+// rateLimitColor picks a color based on how much of the budget is left
+func rateLimitColor(remaining, limit int) *color.Color {
+	if limit == 0 {
+		return color.New(color.FgWhite)
+	}
+	ratio := float64(remaining) / float64(limit)
+	switch {
+	case ratio < 0.1:
+		return color.New(color.FgRed)
+	case ratio < 0.5:
+		return color.New(color.FgYellow)
+	default:
+		return color.New(color.FgGreen)
+	}
+}
+
+// formatReset renders a reset time, or "unknown" when Linear did not report one
+func formatReset(t time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+func init() {
+	rootCmd.AddCommand(apiCmd)
+	apiCmd.AddCommand(apiRateLimitCmd)
+	apiCmd.AddCommand(apiGraphQLCmd)
+
+	// GraphQL command flags
+	apiGraphQLCmd.Flags().String("file", "", "Read the query from a file ('-' for stdin)")
+	apiGraphQLCmd.Flags().StringArrayP("field", "F", []string{}, "Add a typed variable in key=value format (repeatable)")
+	apiGraphQLCmd.Flags().String("variables-file", "", "Read variables from a JSON file ('-' for stdin)")
+	apiGraphQLCmd.Flags().Bool("paginate", false, "Follow pageInfo cursors and merge all pages")
+	apiGraphQLCmd.Flags().String("jq", "", "Select values from the result with a jq-style path")
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func TestParseFieldValue(t *testing.T) {
+	tests := []struct {
+		in   string
+		want interface{}
+	}{
+		{"ENG-123", "ENG-123"},
+		{"42", int64(42)},
+		{"1.5", 1.5},
+		{"true", true},
+		{"null", nil},
+		{`["a","b"]`, []interface{}{"a", "b"}},
+		{`{"eq":"x"}`, map[string]interface{}{"eq": "x"}},
+		{"{not json", "{not json"},
+	}
+	for _, tt := range tests {
+		got, err := parseFieldValue(tt.in)
+		if err != nil {
+			t.Fatalf("parseFieldValue(%q) returned error: %v", tt.in, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseFieldValue(%q) = %#v, want %#v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBuildGraphQLVariablesRejectsBadField(t *testing.T) {
+	c := &cobra.Command{Use: "graphql"}
+	c.Flags().StringArrayP("field", "F", []string{}, "")
+	c.Flags().String("variables-file", "", "")
+	_ = c.Flags().Set("field", "novalue")
+	if _, err := buildGraphQLVariables(c); err == nil {
+		t.Fatal("expected an error for a field without '='")
+	}
+}
+
+func TestExecuteGraphQLPaginatedMergesNodes(t *testing.T) {
+	var cursors []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.Unmarshal(body, &req)
+		cursors = append(cursors, req.Variables["after"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Variables["after"] == nil {
+			_, _ = w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"1"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"2"}],"pageInfo":{"hasNextPage":false,"endCursor":"c2"}}}}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClientWithURL(server.URL, "test-token")
+	data, err := executeGraphQLPaginated(context.Background(), client, "query($after: String) { issues(after: $after) { nodes { id } } }", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("executeGraphQLPaginated returned error: %v", err)
+	}
+
+	nodes := lookupConnection(data, []string{"issues"})["nodes"].([]interface{})
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 merged nodes, got %d", len(nodes))
+	}
+	if len(cursors) != 2 || cursors[1] != "c1" {
+		t.Fatalf("expected second request to use cursor c1, got %v", cursors)
+	}
+}
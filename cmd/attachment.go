@@ -0,0 +1,402 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// assetUploader uploads local files to Linear's storage
+type assetUploader interface {
+	Upload(ctx context.Context, path string) (string, error)
+}
+
+// attachmentAPI defines the interface for attachment operations
+type attachmentAPI interface {
+	assetUploader
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	GetIssueAttachments(ctx context.Context, issueID string, first int, after string) (*api.Attachments, error)
+	CreateAttachment(ctx context.Context, input map[string]interface{}) (*api.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string) error
+}
+
+// Injection points for testing
+var newAttachmentAPIClient = func(authHeader string) attachmentAPI { return api.NewClient(authHeader) }
+var getAttachmentAuthHeader = auth.GetAuthHeader
+
+// markdownImagePattern matches ![alt](target "title"), where target may be
+// wrapped in <> to allow spaces
+var markdownImagePattern = regexp.MustCompile(`!\[([^\]]*)\]\((<[^>]+>|[^)\s]+)(\s+"[^"]*")?\)`)
+
+var attachmentCmd = &cobra.Command{
+	Use:     "attachment",
+	Aliases: []string{"attachments"},
+	Short:   "Manage issue attachments",
+	Long: `Upload files to issues, attach links, and list or delete attachments.
+
+Examples:
+  linctl attachment add ENG-123 ./screenshot.png
+  linctl attachment link ENG-123 https://github.com/org/repo/pull/42 --title "PR #42"
+  linctl attachment list ENG-123
+  linctl attachment delete ATTACHMENT-ID`,
+}
+
+var attachmentAddCmd = &cobra.Command{
+	Use:     "add ISSUE FILE...",
+	Aliases: []string{"upload"},
+	Short:   "Upload files and attach them to an issue",
+	Long: `Upload one or more local files to Linear's storage and attach them to an issue.
+
+Examples:
+  linctl attachment add ENG-123 ./screenshot.png
+  linctl attachment add ENG-123 crash.log trace.json
+  linctl attachment add ENG-123 ./design.pdf --title "Final design"`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runAttachmentAdd(cmd, attachmentClient(plaintext, jsonOut), args[0], args[1:], plaintext, jsonOut)
+	},
+}
+
+var attachmentLinkCmd = &cobra.Command{
+	Use:   "link ISSUE URL",
+	Short: "Attach a URL to an issue",
+	Long: `Attach a link to an issue. Attaching a URL that is already on the issue
+updates the existing attachment.
+
+Examples:
+  linctl attachment link ENG-123 https://sentry.io/issues/42 --title "Sentry: TypeError"
+  linctl attachment link ENG-123 https://github.com/org/repo/pull/42 --title "PR #42" --subtitle "Open"`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runAttachmentLink(cmd, attachmentClient(plaintext, jsonOut), args[0], args[1], plaintext, jsonOut)
+	},
+}
+
+var attachmentListCmd = &cobra.Command{
+	Use:     "list ISSUE",
+	Aliases: []string{"ls"},
+	Short:   "List an issue's attachments",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		columns, _ := selectColumns(cmd, attachmentColumns, plaintext, jsonOut)
+		runAttachmentList(cmd, attachmentClient(plaintext, jsonOut), args[0], columns, plaintext, jsonOut)
+	},
+}
+
+var attachmentDeleteCmd = &cobra.Command{
+	Use:     "delete ATTACHMENT-ID",
+	Aliases: []string{"rm", "remove"},
+	Short:   "Delete an attachment",
+	Long: `Remove an attachment from its issue. Attachment IDs are shown by
+'linctl attachment list --wide'.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runAttachmentDelete(cmd, attachmentClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(attachmentCmd)
+	attachmentCmd.AddCommand(attachmentAddCmd)
+	attachmentCmd.AddCommand(attachmentLinkCmd)
+	attachmentCmd.AddCommand(attachmentListCmd)
+	attachmentCmd.AddCommand(attachmentDeleteCmd)
+
+	attachmentAddCmd.Flags().String("title", "", "Attachment title (default: the file name; single file only)")
+
+	attachmentLinkCmd.Flags().String("title", "", "Attachment title (default: the URL)")
+	attachmentLinkCmd.Flags().String("subtitle", "", "Attachment subtitle")
+
+	attachmentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of attachments to return")
+	addPaginationFlags(attachmentListCmd)
+	addColumnFlags(attachmentListCmd, attachmentColumns)
+
+	attachmentDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+}
+
+// attachmentClient authenticates and returns an attachment API client, exiting on failure
+func attachmentClient(plaintext, jsonOut bool) attachmentAPI {
+	authHeader, err := getAttachmentAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newAttachmentAPIClient(authHeader)
+}
+
+// attachmentColumns are the table columns available to attachment list
+var attachmentColumns = columnSet[api.Attachment]{
+	columns: []tableColumn[api.Attachment]{
+		{Name: "id", Header: "ID", Value: func(a api.Attachment) string { return a.ID }},
+		{Name: "title", Header: "Title", Flex: true, Value: func(a api.Attachment) string { return a.Title }},
+		{Name: "subtitle", Header: "Subtitle", Value: func(a api.Attachment) string {
+			if a.Subtitle == nil {
+				return ""
+			}
+			return *a.Subtitle
+		}},
+		{Name: "url", Header: "URL", Flex: true, Value: func(a api.Attachment) string { return a.URL },
+			Color: func(a api.Attachment) *color.Color { return color.New(color.FgBlue) }},
+		{Name: "creator", Header: "Creator", Value: func(a api.Attachment) string {
+			if a.Creator == nil {
+				return ""
+			}
+			return a.Creator.Name
+		}},
+		{Name: "created", Header: "Created", Value: func(a api.Attachment) string { return a.CreatedAt.Format("2006-01-02") }},
+	},
+	defaults: []string{"title", "url", "creator", "created"},
+	wide:     []string{"id", "title", "subtitle", "url", "creator", "created"},
+}
+
+// fetchAttachmentIssue looks up the issue being attached to, exiting if it cannot be found
+func fetchAttachmentIssue(ctx context.Context, client attachmentAPI, ref string, plaintext, jsonOut bool) *api.Issue {
+	issue, err := client.GetIssue(ctx, ref)
+	if err == nil && issue.ID == "" {
+		err = fmt.Errorf("issue %q %w", ref, api.ErrNotFound)
+	}
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to find issue '%s': %v", ref, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return issue
+}
+
+func runAttachmentAdd(cmd *cobra.Command, client attachmentAPI, ref string, paths []string, plaintext, jsonOut bool) {
+	title, _ := cmd.Flags().GetString("title")
+	if title != "" && len(paths) > 1 {
+		output.Error("--title can only be used with a single file", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	// Check every file before uploading any
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			err = fmt.Errorf("is a directory")
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Cannot attach '%s': %v", path, err), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+	}
+
+	ctx := context.Background()
+	issue := fetchAttachmentIssue(ctx, client, ref, plaintext, jsonOut)
+
+	// --dry-run previews every attachment without uploading, so the files'
+	// asset URLs are placeholders
+	startDryRunBatch()
+	attachments := make([]*api.Attachment, 0, len(paths))
+	for _, path := range paths {
+		assetURL := fmt.Sprintf("<upload of %s>", path)
+		if !dryRunEnabled() {
+			var err error
+			if assetURL, err = client.Upload(ctx, path); err != nil {
+				output.Error(fmt.Sprintf("Failed to upload '%s': %v", path, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		name := title
+		if name == "" {
+			name = filepath.Base(path)
+		}
+		attachment, err := client.CreateAttachment(ctx, map[string]interface{}{
+			"issueId": issue.ID,
+			"url":     assetURL,
+			"title":   name,
+		})
+		if errors.Is(err, api.ErrDryRun) {
+			continue
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to attach '%s': %v", path, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		attachments = append(attachments, attachment)
+
+		if plaintext {
+			fmt.Printf("Attached %s to %s: %s\n", name, issue.Identifier, attachment.URL)
+		} else if !jsonOut {
+			fmt.Printf("%s Attached %s to %s\n",
+				color.New(color.FgGreen).Sprint("📎"),
+				name,
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier))
+		}
+	}
+
+	if finishDryRun(plaintext, jsonOut) {
+		return
+	}
+	if jsonOut {
+		output.JSON(attachments)
+	}
+}
+
+func runAttachmentLink(cmd *cobra.Command, client attachmentAPI, ref, link string, plaintext, jsonOut bool) {
+	if parsed, err := url.Parse(link); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		output.Error(fmt.Sprintf("Invalid URL '%s': expected an absolute URL such as https://example.com/page", link), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	issue := fetchAttachmentIssue(ctx, client, ref, plaintext, jsonOut)
+
+	title, _ := cmd.Flags().GetString("title")
+	if title == "" {
+		title = link
+	}
+	input := map[string]interface{}{
+		"issueId": issue.ID,
+		"url":     link,
+		"title":   title,
+	}
+	if subtitle, _ := cmd.Flags().GetString("subtitle"); subtitle != "" {
+		input["subtitle"] = subtitle
+	}
+
+	attachment, err := client.CreateAttachment(ctx, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to attach link: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(attachment)
+	} else if plaintext {
+		fmt.Printf("Linked %s to %s\n", attachment.URL, issue.Identifier)
+		fmt.Printf("ID: %s\n", attachment.ID)
+	} else {
+		fmt.Printf("%s Linked %s to %s\n",
+			color.New(color.FgGreen).Sprint("🔗"),
+			color.New(color.FgBlue, color.Underline).Sprint(attachment.URL),
+			color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier))
+	}
+}
+
+func runAttachmentList(cmd *cobra.Command, client attachmentAPI, ref string, columns []tableColumn[api.Attachment], plaintext, jsonOut bool) {
+	pageSize, limit := paginationOptions(cmd)
+	attachments, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+		func(ctx context.Context, first int, after string) ([]api.Attachment, api.PageInfo, error) {
+			page, err := client.GetIssueAttachments(ctx, ref, first, after)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list attachments: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if streamed {
+		return
+	}
+
+	if len(attachments) == 0 {
+		output.Info("No attachments found", plaintext, jsonOut)
+		return
+	}
+
+	if jsonOut {
+		output.JSON(attachments)
+		return
+	}
+
+	output.Table(buildTable(columns, attachments, !plaintext), plaintext, jsonOut)
+	if !plaintext {
+		fmt.Printf("\n%s %d attachments\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(attachments))
+		printMoreResultsHint(pageInfo)
+	}
+}
+
+func runAttachmentDelete(cmd *cobra.Command, client attachmentAPI, id string, plaintext, jsonOut bool) {
+	// Confirmation prompt (unless --force, --json or --dry-run)
+	force, _ := cmd.Flags().GetBool("force")
+	if !force && !jsonOut && !dryRunEnabled() {
+		if !confirmAction(fmt.Sprintf("Are you sure you want to delete attachment %s?", id)) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	if err := client.DeleteAttachment(context.Background(), id); err != nil {
+		output.Error(fmt.Sprintf("Failed to delete attachment: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"success": true,
+			"id":      id,
+		})
+	} else if plaintext {
+		fmt.Printf("Deleted attachment %s\n", id)
+	} else {
+		fmt.Printf("%s Deleted attachment\n", color.New(color.FgRed).Sprint("🗑"))
+	}
+}
+
+// uploadMarkdownImages uploads the images markdown embeds by local path,
+// resolved against baseDir, and points them at the uploaded copies. Each
+// file is uploaded once however often it appears.
+func uploadMarkdownImages(ctx context.Context, uploader assetUploader, markdown, baseDir string) (string, error) {
+	uploaded := map[string]string{}
+	var uploadErr error
+	rewritten := markdownImagePattern.ReplaceAllStringFunc(markdown, func(match string) string {
+		parts := markdownImagePattern.FindStringSubmatch(match)
+		target := strings.TrimSuffix(strings.TrimPrefix(parts[2], "<"), ">")
+		if uploadErr != nil || !isLocalPath(target) {
+			return match
+		}
+
+		path := target
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		assetURL, ok := uploaded[path]
+		if !ok {
+			var err error
+			if assetURL, err = uploader.Upload(ctx, path); err != nil {
+				uploadErr = fmt.Errorf("failed to upload image '%s': %w", target, err)
+				return match
+			}
+			uploaded[path] = assetURL
+		}
+		return fmt.Sprintf("![%s](%s%s)", parts[1], assetURL, parts[3])
+	})
+	return rewritten, uploadErr
+}
+
+// isLocalPath reports whether a markdown link target is a file path rather
+// than a URL or an anchor
+func isLocalPath(target string) bool {
+	if target == "" || strings.HasPrefix(target, "#") || strings.Contains(target, "://") {
+		return false
+	}
+	scheme, _, found := strings.Cut(target, ":")
+	// A single letter before the colon is a Windows drive, not a scheme
+	return !found || len(scheme) == 1 || strings.ContainsAny(scheme, `/\.`)
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockAttachmentClient struct {
+	uploads []string
+	created []map[string]interface{}
+}
+
+func (m *mockAttachmentClient) Upload(ctx context.Context, path string) (string, error) {
+	m.uploads = append(m.uploads, path)
+	return "https://uploads.linear.app/" + filepath.Base(path), nil
+}
+
+func (m *mockAttachmentClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	return &api.Issue{ID: "issue-1", Identifier: id}, nil
+}
+
+func (m *mockAttachmentClient) GetIssueAttachments(ctx context.Context, issueID string, first int, after string) (*api.Attachments, error) {
+	return &api.Attachments{}, nil
+}
+
+func (m *mockAttachmentClient) CreateAttachment(ctx context.Context, input map[string]interface{}) (*api.Attachment, error) {
+	m.created = append(m.created, input)
+	if api.DryRun != nil {
+		_ = api.DryRun(api.MutationPreview{Operation: "CreateAttachment", Variables: map[string]interface{}{"input": input}})
+		return nil, api.ErrDryRun
+	}
+	return &api.Attachment{ID: "att-1", URL: input["url"].(string), Title: input["title"].(string)}, nil
+}
+
+func (m *mockAttachmentClient) DeleteAttachment(ctx context.Context, id string) error {
+	return nil
+}
+
+func TestAttachmentAddUploadsEachFile(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"shot.png", "crash.log"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("title", "", "")
+	mc := &mockAttachmentClient{}
+	out := captureMilestoneStdout(t, func() {
+		runAttachmentAdd(cmd, mc, "ENG-1", paths, true, false)
+	})
+
+	want := []map[string]interface{}{
+		{"issueId": "issue-1", "url": "https://uploads.linear.app/shot.png", "title": "shot.png"},
+		{"issueId": "issue-1", "url": "https://uploads.linear.app/crash.log", "title": "crash.log"},
+	}
+	if !reflect.DeepEqual(mc.created, want) {
+		t.Fatalf("created %v, want %v", mc.created, want)
+	}
+	if !contains(out, "Attached crash.log to ENG-1") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestAttachmentAddDryRunPreviewsEveryFile(t *testing.T) {
+	api.DryRun = previewMutation
+	defer func() {
+		api.DryRun = nil
+		dryRunState.batch = false
+		dryRunState.mutations = nil
+	}()
+
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"shot.png", "crash.log"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("title", "", "")
+	mc := &mockAttachmentClient{}
+	out := captureMilestoneStdout(t, func() {
+		runAttachmentAdd(cmd, mc, "ENG-1", paths, true, false)
+	})
+
+	if len(mc.uploads) != 0 {
+		t.Fatalf("dry run uploaded %v", mc.uploads)
+	}
+	if len(mc.created) != 2 || mc.created[1]["url"] != "<upload of "+paths[1]+">" {
+		t.Fatalf("created %v, want a placeholder attachment per file", mc.created)
+	}
+	if !contains(out, "Dry run: 2 mutation(s) not sent") || contains(out, "Attached") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestUploadMarkdownImages(t *testing.T) {
+	markdown := "Before ![shot](img/shot.png) and again ![same](<img/shot.png> \"Title\")\n" +
+		"![remote](https://example.com/a.png) ![inline](data:image/png;base64,AAAA) [not an image](notes.md)"
+
+	mc := &mockAttachmentClient{}
+	got, err := uploadMarkdownImages(context.Background(), mc, markdown, "docs")
+	if err != nil {
+		t.Fatalf("uploadMarkdownImages: %v", err)
+	}
+
+	want := "Before ![shot](https://uploads.linear.app/shot.png) and again ![same](https://uploads.linear.app/shot.png \"Title\")\n" +
+		"![remote](https://example.com/a.png) ![inline](data:image/png;base64,AAAA) [not an image](notes.md)"
+	if got != want {
+		t.Fatalf("rewritten =\n%s\nwant\n%s", got, want)
+	}
+	if !reflect.DeepEqual(mc.uploads, []string{filepath.Join("docs", "img", "shot.png")}) {
+		t.Fatalf("uploads = %v, want one upload relative to the file", mc.uploads)
+	}
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/dorkitude/linctl/pkg/auth"
 	"github.com/dorkitude/linctl/pkg/output"
@@ -21,7 +25,15 @@ Examples:
   linctl auth              # Interactive authentication
   linctl auth login        # Same as above
   linctl auth status       # Check authentication status
-  linctl auth logout       # Clear stored credentials`,
+  linctl auth logout       # Clear stored credentials
+  linctl auth migrate --to keyring     # Move credentials into the OS keyring
+
+Profiles:
+  linctl auth login --profile client   # Store a key under the "client" profile
+  linctl auth switch client            # Make "client" the current profile
+  linctl auth list                     # List stored profiles
+  linctl --profile client issue list   # Use a profile for one command
+  LINCTL_PROFILE=client linctl ...     # Same, via the environment`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Default behavior is to run login
 		loginCmd.Run(cmd, args)
@@ -31,11 +43,46 @@ Examples:
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Linear",
-	Long:  `Authenticate with Linear using Personal API Key.`,
+	Long: `Authenticate with Linear using Personal API Key.
+
+The key is stored under the profile selected with --profile or LINCTL_PROFILE,
+or the current profile ("default" if none exists yet).
+
+Use --with-token to read the key from stdin without prompting, e.g. in CI:
+  echo "$LINEAR_KEY" | linctl auth login --with-token
+
+Use --oauth to sign in through a Linear OAuth application instead. linctl
+starts a callback server on http://127.0.0.1:<port>/callback (register this
+redirect URI on the application), opens the authorize URL, and stores the
+access and refresh tokens. Tokens are refreshed automatically when they expire.
+  linctl auth login --oauth --client-id YOUR_CLIENT_ID
+
+LINCTL_API_KEY and LINEAR_API_KEY, when set, take precedence over stored keys.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		if useOAuth, _ := cmd.Flags().GetBool("oauth"); useOAuth {
+			loginWithOAuth(cmd, plaintext, jsonOut)
+			return
+		}
+
+		if withToken, _ := cmd.Flags().GetBool("with-token"); withToken {
+			user, err := auth.LoginWithToken(os.Stdin)
+			if err != nil {
+				output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if jsonOut {
+				output.JSON(map[string]interface{}{
+					"status":  "success",
+					"profile": auth.ActiveProfile(),
+					"user":    user,
+				})
+			}
+			return
+		}
+
 		if !plaintext && !jsonOut {
 			fmt.Println(color.New(color.FgCyan, color.Bold).Sprint("🔐 Linear Authentication"))
 			fmt.Println()
@@ -44,7 +91,7 @@ var loginCmd = &cobra.Command{
 		err := auth.Login(plaintext, jsonOut)
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if !plaintext && !jsonOut {
@@ -53,6 +100,7 @@ var loginCmd = &cobra.Command{
 			output.JSON(map[string]interface{}{
 				"status":  "success",
 				"message": "Successfully authenticated with Linear",
+				"profile": auth.ActiveProfile(),
 			})
 		} else {
 			fmt.Println("Successfully authenticated with Linear")
@@ -60,6 +108,50 @@ var loginCmd = &cobra.Command{
 	},
 }
 
+// loginWithOAuth runs the OAuth login flow for loginCmd
+func loginWithOAuth(cmd *cobra.Command, plaintext, jsonOut bool) {
+	clientID, _ := cmd.Flags().GetString("client-id")
+	scopes, _ := cmd.Flags().GetString("scopes")
+	port, _ := cmd.Flags().GetInt("port")
+	noBrowser, _ := cmd.Flags().GetBool("no-browser")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Keep stdout clean for JSON consumers
+	var out io.Writer = os.Stdout
+	if jsonOut {
+		out = os.Stderr
+	}
+
+	user, err := auth.LoginWithOAuth(ctx, auth.OAuthConfig{
+		ClientID:     clientID,
+		Scopes:       scopes,
+		RedirectPort: port,
+		NoBrowser:    noBrowser,
+	}, out)
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"status":  "success",
+			"method":  "oauth",
+			"profile": auth.ActiveProfile(),
+			"user":    user,
+		})
+	} else if plaintext {
+		fmt.Printf("Authenticated as %s (%s) via OAuth\n", user.Name, user.Email)
+	} else {
+		fmt.Printf("\n%s Authenticated as %s (%s) via OAuth\n",
+			color.New(color.FgGreen).Sprint("✅"),
+			color.New(color.FgCyan).Sprint(user.Name),
+			color.New(color.FgCyan).Sprint(user.Email))
+	}
+}
+
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check authentication status",
@@ -80,20 +172,25 @@ var statusCmd = &cobra.Command{
 			} else {
 				fmt.Println("Not authenticated")
 			}
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
+		source, _ := auth.GetCredentialSource()
 		if jsonOut {
 			output.JSON(map[string]interface{}{
 				"authenticated": true,
+				"profile":       source.Profile,
+				"source":        source,
 				"user":          user,
 			})
 		} else if plaintext {
 			fmt.Printf("Authenticated as: %s (%s)\n", user.Name, user.Email)
+			fmt.Printf("Source: %s\n", source)
 		} else {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Authenticated"))
 			fmt.Printf("User: %s\n", color.New(color.FgCyan).Sprint(user.Name))
 			fmt.Printf("Email: %s\n", color.New(color.FgCyan).Sprint(user.Email))
+			fmt.Printf("Source: %s\n", color.New(color.FgCyan).Sprint(source))
 		}
 	},
 }
@@ -101,7 +198,7 @@ var statusCmd = &cobra.Command{
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from Linear",
-	Long:  `Clear stored Linear credentials.`,
+	Long:  `Clear the stored Linear credentials for the active profile.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -109,7 +206,7 @@ var logoutCmd = &cobra.Command{
 		err := auth.Logout()
 		if err != nil {
 			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -125,6 +222,137 @@ var logoutCmd = &cobra.Command{
 	},
 }
 
+var listProfilesCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List auth profiles",
+	Long:    `List the stored auth profiles. The current profile is used when neither --profile nor LINCTL_PROFILE is set.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		profiles, err := auth.ListProfiles()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list profiles: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(profiles)
+			return
+		}
+
+		if len(profiles) == 0 {
+			output.Info("No profiles found. Run 'linctl auth login' to create one.", plaintext, jsonOut)
+			return
+		}
+
+		for _, p := range profiles {
+			if plaintext {
+				marker := ""
+				if p.Current {
+					marker = " (current)"
+				}
+				fmt.Printf("%s%s\n", p.Name, marker)
+				continue
+			}
+
+			if p.Active {
+				fmt.Printf("%s %s", color.New(color.FgGreen).Sprint("*"), color.New(color.FgCyan, color.Bold).Sprint(p.Name))
+			} else {
+				fmt.Printf("  %s", p.Name)
+			}
+			if p.Current {
+				fmt.Print(color.New(color.FgWhite, color.Faint).Sprint(" (current)"))
+			}
+			fmt.Println()
+		}
+	},
+}
+
+var switchProfileCmd = &cobra.Command{
+	Use:   "switch NAME",
+	Short: "Switch the current auth profile",
+	Long:  `Make NAME the profile used when neither --profile nor LINCTL_PROFILE is set.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		err := auth.SwitchProfile(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to switch profile: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"status":  "success",
+				"profile": args[0],
+			})
+		} else if plaintext {
+			fmt.Printf("Switched to profile %s\n", args[0])
+		} else {
+			fmt.Printf("%s Switched to profile %s\n",
+				color.New(color.FgGreen).Sprint("✅"),
+				color.New(color.FgCyan).Sprint(args[0]))
+		}
+	},
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move stored credentials to another backend",
+	Long: `Move stored credentials into another credential store and remove them
+from the old one.
+
+Backends:
+  keyring     OS keyring (Secret Service, macOS Keychain, Windows Credential Manager)
+  encrypted   ~/.linctl-auth.age, encrypted with a passphrase (age/scrypt).
+              The passphrase is read from LINCTL_PASSPHRASE or prompted for.
+  file        ~/.linctl-auth.json in plaintext (the fallback)
+
+linctl finds credentials in whichever backend holds them. Set
+LINCTL_CREDENTIAL_STORE to force a backend.
+
+Examples:
+  linctl auth migrate --to keyring
+  linctl auth migrate --to encrypted`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		to, _ := cmd.Flags().GetString("to")
+		if to == "" {
+			output.Error(fmt.Sprintf("--to is required (%s)", strings.Join(auth.StoreNames, ", ")), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		from, location, err := auth.MigrateCredentials(to)
+		if err != nil {
+			output.Error(fmt.Sprintf("Migration failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"status":   "success",
+				"from":     from,
+				"to":       to,
+				"location": location,
+			})
+		} else if plaintext {
+			fmt.Printf("Moved credentials from %s to %s (%s)\n", from, to, location)
+		} else {
+			fmt.Printf("%s Moved credentials from %s to %s\n",
+				color.New(color.FgGreen).Sprint("✅"),
+				color.New(color.FgCyan).Sprint(from),
+				color.New(color.FgCyan).Sprint(to))
+			fmt.Printf("Location: %s\n", color.New(color.FgCyan).Sprint(location))
+		}
+	},
+}
+
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Show current user",
@@ -139,6 +367,20 @@ func init() {
 	authCmd.AddCommand(loginCmd)
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(logoutCmd)
+	authCmd.AddCommand(listProfilesCmd)
+	authCmd.AddCommand(switchProfileCmd)
+	authCmd.AddCommand(migrateCmd)
+
+	// Migrate command flags
+	migrateCmd.Flags().String("to", "", "Destination backend: keyring, encrypted or file")
+
+	// Login command flags
+	loginCmd.Flags().Bool("with-token", false, "Read the API key from stdin without prompting")
+	loginCmd.Flags().Bool("oauth", false, "Sign in with OAuth (authorization code with PKCE)")
+	loginCmd.Flags().String("client-id", "", "OAuth application client ID (default $LINCTL_OAUTH_CLIENT_ID)")
+	loginCmd.Flags().String("scopes", auth.DefaultScopes, "Comma-separated OAuth scopes")
+	loginCmd.Flags().Int("port", auth.DefaultRedirectPort, "Loopback port for the OAuth callback (0 picks a free port)")
+	loginCmd.Flags().Bool("no-browser", false, "Print the OAuth authorize URL instead of opening a browser")
 
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// tableColumn describes one selectable column of a list command's table
+type tableColumn[T any] struct {
+	Name   string
+	Header string
+	Value  func(T) string
+	// Color optionally styles the value in rich output
+	Color func(T) *color.Color
+	// Flex columns are truncated first when the table is wider than the terminal
+	Flex bool
+}
+
+// columnSet is the set of columns a list command can show, with the
+// columns shown by default and by --wide
+type columnSet[T any] struct {
+	columns  []tableColumn[T]
+	defaults []string
+	wide     []string
+}
+
+// names returns every column name in declaration order
+func (s columnSet[T]) names() []string {
+	names := make([]string, len(s.columns))
+	for i, col := range s.columns {
+		names[i] = col.Name
+	}
+	return names
+}
+
+// lookup finds a column by name, ignoring case
+func (s columnSet[T]) lookup(name string) (tableColumn[T], bool) {
+	for _, col := range s.columns {
+		if strings.EqualFold(col.Name, name) {
+			return col, true
+		}
+	}
+	return tableColumn[T]{}, false
+}
+
+// addColumnFlags registers --columns and --wide on a list command
+func addColumnFlags[T any](cmd *cobra.Command, set columnSet[T]) {
+	cmd.Flags().StringSlice("columns", nil,
+		fmt.Sprintf("Comma-separated table columns (%s)", strings.Join(set.names(), ", ")))
+	cmd.Flags().Bool("wide", false,
+		fmt.Sprintf("Show more columns (%s)", strings.Join(set.wide, ",")))
+}
+
+// parse resolves --columns and --wide to the columns to show. custom is
+// true when either flag was given.
+func (s columnSet[T]) parse(cmd *cobra.Command) (columns []tableColumn[T], custom bool, err error) {
+	names := s.defaults
+	if wide, _ := cmd.Flags().GetBool("wide"); wide {
+		names = s.wide
+		custom = true
+	}
+	if requested, _ := cmd.Flags().GetStringSlice("columns"); len(requested) > 0 {
+		names = requested
+		custom = true
+	}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		col, ok := s.lookup(name)
+		if !ok {
+			return nil, false, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(s.names(), ", "))
+		}
+		columns = append(columns, col)
+	}
+	if len(columns) == 0 {
+		return nil, false, fmt.Errorf("no columns selected")
+	}
+	return columns, custom, nil
+}
+
+// selectColumns is parse for use in a command's Run; it exits on invalid
+// column names so errors surface before any API call
+func selectColumns[T any](cmd *cobra.Command, set columnSet[T], plaintext, jsonOut bool) ([]tableColumn[T], bool) {
+	columns, custom, err := set.parse(cmd)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	return columns, custom
+}
+
+// buildTable renders items into table data using the given columns
+func buildTable[T any](columns []tableColumn[T], items []T, colored bool) output.TableData {
+	data := output.TableData{
+		Headers: make([]string, len(columns)),
+		Rows:    make([][]string, len(items)),
+		Flex:    make([]bool, len(columns)),
+	}
+	for i, col := range columns {
+		data.Headers[i] = col.Header
+		data.Flex[i] = col.Flex
+	}
+	for r, item := range items {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			value := col.Value(item)
+			if colored && col.Color != nil && value != "" {
+				if c := col.Color(item); c != nil {
+					value = c.Sprint(value)
+				}
+			}
+			row[i] = value
+		}
+		data.Rows[r] = row
+	}
+	return data
+}
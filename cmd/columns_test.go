@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func newColumnsCommand(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "list"}
+	addColumnFlags(cmd, issueColumns)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("ParseFlags() error = %v", err)
+	}
+	return cmd
+}
+
+func columnNames(columns []tableColumn[api.Issue]) string {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.Name
+	}
+	return strings.Join(names, ",")
+}
+
+func TestIssueColumnsSelection(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		want   string
+		custom bool
+	}{
+		{"defaults", nil, "title,state,assignee,team,project,created,url", false},
+		{"wide", []string{"--wide"}, strings.Join(issueColumns.wide, ","), true},
+		{"explicit", []string{"--columns", "identifier,title,priority,estimate,labels,cycle,due"}, "identifier,title,priority,estimate,labels,cycle,due", true},
+		{"case insensitive", []string{"--columns", "Identifier, Title"}, "identifier,title", true},
+		{"columns win over wide", []string{"--wide", "--columns", "url"}, "url", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, custom, err := issueColumns.parse(newColumnsCommand(t, tt.args...))
+			if err != nil {
+				t.Fatalf("parse() error = %v", err)
+			}
+			if got := columnNames(columns); got != tt.want {
+				t.Errorf("columns = %s, want %s", got, tt.want)
+			}
+			if custom != tt.custom {
+				t.Errorf("custom = %v, want %v", custom, tt.custom)
+			}
+		})
+	}
+}
+
+func TestIssueColumnsRejectUnknownName(t *testing.T) {
+	_, _, err := issueColumns.parse(newColumnsCommand(t, "--columns", "title,bogus"))
+	if err == nil || !strings.Contains(err.Error(), `"bogus"`) {
+		t.Fatalf("parse() error = %v, want unknown column error", err)
+	}
+}
+
+func TestBuildTableFormatsIssueFields(t *testing.T) {
+	estimate := 2.5
+	due := "2024-06-01"
+	issue := api.Issue{
+		Identifier:    "ENG-7",
+		Title:         "Fix login",
+		PriorityLabel: "High",
+		Estimate:      &estimate,
+		DueDate:       &due,
+		Labels:        &api.Labels{Nodes: []api.Label{{Name: "bug"}, {Name: "auth"}}},
+		Cycle:         &api.Cycle{Number: 12},
+	}
+
+	columns, _, err := issueColumns.parse(newColumnsCommand(t, "--columns", "identifier,title,priority,estimate,labels,cycle,due,assignee"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buildTable(columns, []api.Issue{issue}, false)
+
+	want := []string{"ENG-7", "Fix login", "High", "2.5", "bug, auth", "Cycle 12", "2024-06-01", "Unassigned"}
+	if strings.Join(data.Rows[0], "|") != strings.Join(want, "|") {
+		t.Fatalf("row = %q, want %q", data.Rows[0], want)
+	}
+	if !data.Flex[1] || data.Flex[0] {
+		t.Fatalf("flex = %v, want title flexible and identifier fixed", data.Flex)
+	}
+}
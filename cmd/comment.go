@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/dorkitude/linctl/pkg/api"
@@ -19,36 +18,46 @@ import (
 var commentCmd = &cobra.Command{
 	Use:   "comment",
 	Short: "Manage issue comments",
-	Long: `Manage comments on Linear issues including listing and creating comments.
+	Long: `Manage comments on Linear issues: list threads, add comments and
+replies, edit, delete and resolve them.
 
 Examples:
-  linctl comment list LIN-123        # List comments for an issue
-  linctl comment create LIN-123 --body "This is fixed"  # Add a comment`,
+  linctl comment list LIN-123        # List comment threads for an issue
+  linctl comment create LIN-123 --body "This is fixed"  # Add a comment
+  linctl comment reply COMMENT-ID --body "Thanks!"      # Reply in a thread
+  linctl comment resolve COMMENT-ID                     # Resolve a thread`,
 }
 
 var commentListCmd = &cobra.Command{
 	Use:     "list ISSUE-ID",
 	Aliases: []string{"ls"},
 	Short:   "List comments for an issue",
-	Long:    `List all comments for a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `List the comments on an issue as threads, with replies indented under
+the comment that started them. Comment IDs are shown for use with
+'linctl comment reply', 'edit', 'delete' and 'resolve'.
+
+With --columns or --wide, comments are listed as a flat table instead, one
+row per comment in the order Linear returns them; the thread column holds
+the ID of the comment a reply belongs to.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		issueID := args[0]
+		columns, customColumns := selectColumns(cmd, commentColumns, plaintext, jsonOut)
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
-		// Get limit
-		limit, _ := cmd.Flags().GetInt("limit")
+		// Get pagination options
+		pageSize, limit := paginationOptions(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -64,68 +73,75 @@ var commentListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
 		// Get comments
-		comments, err := client.GetIssueComments(context.Background(), issueID, limit, "", orderBy)
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Comment, api.PageInfo, error) {
+				page, err := client.GetIssueComments(ctx, issueID, first, after, orderBy)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		if streamed {
+			return
 		}
+		comments := &api.Comments{Nodes: nodes, PageInfo: pageInfo}
 
 		// Handle output
 		if jsonOut {
 			output.JSON(comments.Nodes)
-		} else if plaintext {
-			for i, comment := range comments.Nodes {
-				if i > 0 {
-					fmt.Println("---")
-				}
-				fmt.Printf("Author: %s\n", comment.User.Name)
-				fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
-				fmt.Printf("Comment:\n%s\n", comment.Body)
-			}
-		} else {
-			// Rich display
-			if len(comments.Nodes) == 0 {
-				fmt.Printf("\n%s No comments on issue %s\n",
-					color.New(color.FgYellow).Sprint("ℹ️"),
-					color.New(color.FgCyan).Sprint(issueID))
-				return
-			}
-
-			fmt.Printf("\n%s Comments on %s (%d)\n\n",
-				color.New(color.FgCyan, color.Bold).Sprint("💬"),
-				color.New(color.FgCyan).Sprint(issueID),
-				len(comments.Nodes))
-
-			for i, comment := range comments.Nodes {
-				if i > 0 {
-					fmt.Println(strings.Repeat("─", 50))
-				}
-
-				// Header with author and time
-				timeAgo := formatTimeAgo(comment.CreatedAt)
-				fmt.Printf("%s %s %s\n",
-					color.New(color.FgCyan, color.Bold).Sprint(comment.User.Name),
-					color.New(color.FgWhite, color.Faint).Sprint("•"),
-					color.New(color.FgWhite, color.Faint).Sprint(timeAgo))
+			return
+		}
+		if customColumns {
+			output.Table(buildTable(columns, comments.Nodes, !plaintext), plaintext, jsonOut)
+			return
+		}
+		printCommentThreads(issueID, comments.Nodes, plaintext)
+	},
+}
 
-				// Comment body
-				fmt.Printf("\n%s\n\n", comment.Body)
+// commentColumns are the table columns available to comment list with
+// --columns or --wide; without them comments are printed as threads
+var commentColumns = columnSet[api.Comment]{
+	columns: []tableColumn[api.Comment]{
+		{Name: "id", Header: "ID", Value: func(c api.Comment) string { return c.ID }},
+		{Name: "thread", Header: "Thread", Value: func(c api.Comment) string { return threadRootID(&c) }},
+		{Name: "author", Header: "Author", Value: func(c api.Comment) string { return commentAuthor(&c) },
+			Color: func(api.Comment) *color.Color { return color.New(color.FgCyan) }},
+		{Name: "body", Header: "Body", Flex: true, Value: func(c api.Comment) string { return commentExcerpt(c.Body, 200) }},
+		{Name: "reactions", Header: "Reactions", Value: func(c api.Comment) string { return reactionSummary(c.Reactions, true) }},
+		{Name: "created", Header: "Created", Value: func(c api.Comment) string { return c.CreatedAt.Format("2006-01-02 15:04") }},
+		{Name: "resolved", Header: "Resolved", Value: func(c api.Comment) string {
+			if c.ResolvedAt == nil {
+				return ""
 			}
-		}
+			return c.ResolvedAt.Format("2006-01-02")
+		}, Color: func(api.Comment) *color.Color { return color.New(color.FgGreen) }},
 	},
+	defaults: []string{"author", "body", "created"},
+	wide:     []string{"id", "thread", "author", "body", "reactions", "created", "resolved"},
 }
 
 var commentCreateCmd = &cobra.Command{
 	Use:     "create ISSUE-ID",
 	Aliases: []string{"add", "new"},
 	Short:   "Create a comment on an issue",
-	Long:    `Add a new comment to a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Add a new comment to a specific issue.
+
+Examples:
+  linctl comment create LIN-123 --body "Fixed in the latest release"
+  linctl comment create LIN-123 --body-file notes.md
+  git log -1 --format=%B | linctl comment create LIN-123 --body-file -
+  linctl comment create LIN-123 --editor`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -135,24 +151,24 @@ var commentCreateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get comment body
-		body, _ := cmd.Flags().GetString("body")
+		body, _ := composeFlagText(cmd, "body", "body-file", nil, client, plaintext, jsonOut)
 		if body == "" {
-			output.Error("Comment body is required (--body)", plaintext, jsonOut)
-			os.Exit(1)
+			output.Error("Comment body is required (--body, --body-file or --editor)", plaintext, jsonOut)
+			os.Exit(exitUsage)
 		}
 
 		// Create comment
 		comment, err := client.CreateComment(context.Background(), issueID, body)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -218,8 +234,11 @@ func init() {
 	// List command flags
 	commentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of comments to return")
 	commentListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	addPaginationFlags(commentListCmd)
+	addColumnFlags(commentListCmd, commentColumns)
 
 	// Create command flags
-	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required)")
-	_ = commentCreateCmd.MarkFlagRequired("body")
+	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body")
+	commentCreateCmd.Flags().String("body-file", "", "Read the comment body from a markdown file ('-' for stdin)")
+	commentCreateCmd.Flags().Bool("editor", false, "Write the comment in $EDITOR")
 }
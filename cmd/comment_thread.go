@@ -0,0 +1,418 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// commentThreadAPI defines the interface for working on existing comments
+type commentThreadAPI interface {
+	assetUploader
+	GetComment(ctx context.Context, id string) (*api.Comment, error)
+	CreateCommentReply(ctx context.Context, issueID, parentID, body string) (*api.Comment, error)
+	UpdateComment(ctx context.Context, id string, body string) (*api.Comment, error)
+	DeleteComment(ctx context.Context, id string) error
+	ResolveComment(ctx context.Context, id string) (*api.Comment, error)
+	UnresolveComment(ctx context.Context, id string) (*api.Comment, error)
+}
+
+// Injection points for testing
+var newCommentThreadAPIClient = func(authHeader string) commentThreadAPI { return api.NewClient(authHeader) }
+var getCommentThreadAuthHeader = auth.GetAuthHeader
+
+var commentReplyCmd = &cobra.Command{
+	Use:   "reply COMMENT-ID",
+	Short: "Reply in a comment's thread",
+	Long: `Reply inside an existing comment thread instead of starting a new one.
+Replying to a reply adds to the same thread. Comment IDs are shown by
+'linctl comment list'.
+
+Examples:
+  linctl comment reply 3f2b... --body "Done, thanks!"
+  linctl comment reply 3f2b... --body-file review.md
+  linctl comment reply 3f2b... --editor`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runCommentReply(cmd, commentThreadClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:     "edit COMMENT-ID",
+	Aliases: []string{"update"},
+	Short:   "Edit a comment",
+	Long: `Replace the body of a comment. With --editor, the editor starts from the
+current body.
+
+Examples:
+  linctl comment edit 3f2b... --body "Updated text"
+  linctl comment edit 3f2b... --editor`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runCommentEdit(cmd, commentThreadClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:     "delete COMMENT-ID",
+	Aliases: []string{"rm", "remove"},
+	Short:   "Delete a comment",
+	Long: `Delete a comment. Deleting the first comment of a thread deletes its replies too.
+
+Examples:
+  linctl comment delete 3f2b...
+  linctl comment delete 3f2b... --force  # Skip confirmation prompt`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runCommentDelete(cmd, commentThreadClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve COMMENT-ID",
+	Short: "Resolve a comment thread",
+	Long: `Mark a comment thread as resolved. The ID may be of the thread's first
+comment or of any reply.
+
+Examples:
+  linctl comment resolve 3f2b...`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runCommentResolve(commentThreadClient(plaintext, jsonOut), args[0], true, plaintext, jsonOut)
+	},
+}
+
+var commentUnresolveCmd = &cobra.Command{
+	Use:   "unresolve COMMENT-ID",
+	Short: "Reopen a resolved comment thread",
+	Long: `Reopen a resolved comment thread. The ID may be of the thread's first
+comment or of any reply.
+
+Examples:
+  linctl comment unresolve 3f2b...`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runCommentResolve(commentThreadClient(plaintext, jsonOut), args[0], false, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentReplyCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+	commentCmd.AddCommand(commentResolveCmd)
+	commentCmd.AddCommand(commentUnresolveCmd)
+
+	commentReplyCmd.Flags().StringP("body", "b", "", "Reply body")
+	commentReplyCmd.Flags().String("body-file", "", "Read the reply from a markdown file ('-' for stdin)")
+	commentReplyCmd.Flags().Bool("editor", false, "Write the reply in $EDITOR")
+
+	commentEditCmd.Flags().StringP("body", "b", "", "New comment body")
+	commentEditCmd.Flags().String("body-file", "", "Read the new body from a markdown file ('-' for stdin)")
+	commentEditCmd.Flags().Bool("editor", false, "Edit the current body in $EDITOR")
+
+	commentDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+}
+
+// commentThreadClient authenticates and returns a comment API client, exiting on failure
+func commentThreadClient(plaintext, jsonOut bool) commentThreadAPI {
+	authHeader, err := getCommentThreadAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newCommentThreadAPIClient(authHeader)
+}
+
+// fetchComment looks up a comment, exiting if it cannot be found
+func fetchComment(ctx context.Context, client commentThreadAPI, id string, plaintext, jsonOut bool) *api.Comment {
+	comment, err := client.GetComment(ctx, id)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to find comment '%s': %v", id, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return comment
+}
+
+// threadRootID is the ID of the first comment in comment's thread
+func threadRootID(comment *api.Comment) string {
+	if comment.Parent != nil && comment.Parent.ID != "" {
+		return comment.Parent.ID
+	}
+	return comment.ID
+}
+
+func runCommentReply(cmd *cobra.Command, client commentThreadAPI, id string, plaintext, jsonOut bool) {
+	// Check the parent before composing, so a mistyped ID does not discard
+	// editor text or orphan uploads
+	ctx := context.Background()
+	parent := fetchComment(ctx, client, id, plaintext, jsonOut)
+	if parent.Issue == nil {
+		output.Error(fmt.Sprintf("Comment '%s' is not on an issue", id), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	body, _ := composeFlagText(cmd, "body", "body-file", nil, client, plaintext, jsonOut)
+	if body == "" {
+		output.Error("Reply body is required (--body, --body-file or --editor)", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	reply, err := client.CreateCommentReply(ctx, parent.Issue.ID, threadRootID(parent), body)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to reply: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(reply)
+	} else if plaintext {
+		fmt.Printf("Replied on %s\n", parent.Issue.Identifier)
+		fmt.Printf("ID: %s\n", reply.ID)
+		fmt.Printf("Thread: %s\n", threadRootID(parent))
+	} else {
+		fmt.Printf("%s Replied to %s on %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			commentAuthor(parent),
+			color.New(color.FgCyan, color.Bold).Sprint(parent.Issue.Identifier))
+		fmt.Printf("\n%s\n", reply.Body)
+	}
+}
+
+func runCommentEdit(cmd *cobra.Command, client commentThreadAPI, id string, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	currentBody := func() string { return fetchComment(ctx, client, id, plaintext, jsonOut).Body }
+	body, _ := composeFlagText(cmd, "body", "body-file", currentBody, client, plaintext, jsonOut)
+	if body == "" {
+		output.Error("New body is required (--body, --body-file or --editor)", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	comment, err := client.UpdateComment(ctx, id, body)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to update comment: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(comment)
+	} else if plaintext {
+		fmt.Printf("Updated comment %s\n", comment.ID)
+	} else {
+		fmt.Printf("%s Updated comment\n", color.New(color.FgGreen).Sprint("✓"))
+		fmt.Printf("\n%s\n", comment.Body)
+	}
+}
+
+func runCommentDelete(cmd *cobra.Command, client commentThreadAPI, id string, plaintext, jsonOut bool) {
+	force, _ := cmd.Flags().GetBool("force")
+	ctx := context.Background()
+
+	// Confirmation prompt (unless --force, --json or --dry-run)
+	if !force && !jsonOut && !dryRunEnabled() {
+		comment := fetchComment(ctx, client, id, plaintext, jsonOut)
+		prompt := fmt.Sprintf("Are you sure you want to delete %s's comment %q?", commentAuthor(comment), commentExcerpt(comment.Body, 60))
+		if !confirmAction(prompt) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	if err := client.DeleteComment(ctx, id); err != nil {
+		output.Error(fmt.Sprintf("Failed to delete comment: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"success": true,
+			"id":      id,
+		})
+	} else if plaintext {
+		fmt.Printf("Deleted comment %s\n", id)
+	} else {
+		fmt.Printf("%s Deleted comment\n", color.New(color.FgRed).Sprint("🗑"))
+	}
+}
+
+func runCommentResolve(client commentThreadAPI, id string, resolve bool, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	rootID := threadRootID(fetchComment(ctx, client, id, plaintext, jsonOut))
+
+	var comment *api.Comment
+	var err error
+	verb, done := "resolve", "Resolved"
+	if resolve {
+		comment, err = client.ResolveComment(ctx, rootID)
+	} else {
+		verb, done = "unresolve", "Unresolved"
+		comment, err = client.UnresolveComment(ctx, rootID)
+	}
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to %s thread: %v", verb, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(comment)
+	} else if plaintext {
+		fmt.Printf("%s thread %s\n", done, rootID)
+	} else if resolve {
+		fmt.Printf("%s Resolved thread started by %s\n", color.New(color.FgGreen).Sprint("✓"), commentAuthor(comment))
+	} else {
+		fmt.Printf("%s Reopened thread started by %s\n", color.New(color.FgYellow).Sprint("↺"), commentAuthor(comment))
+	}
+}
+
+// nestComments groups replies under the first comment of their thread.
+// Replies whose thread was not fetched stay at the top level.
+func nestComments(comments []api.Comment) []api.Comment {
+	roots := map[string]int{}
+	for i, comment := range comments {
+		if comment.Parent == nil {
+			roots[comment.ID] = i
+		}
+	}
+
+	replies := map[string][]api.Comment{}
+	var top []api.Comment
+	for _, comment := range comments {
+		if comment.Parent != nil {
+			if _, ok := roots[comment.Parent.ID]; ok {
+				replies[comment.Parent.ID] = append(replies[comment.Parent.ID], comment)
+				continue
+			}
+		}
+		top = append(top, comment)
+	}
+
+	for i, comment := range top {
+		if thread := replies[comment.ID]; len(thread) > 0 {
+			top[i].Children = &api.Comments{Nodes: thread}
+		}
+	}
+	return top
+}
+
+// printCommentThreads prints comments as threads, with replies indented
+// under the comment that started them
+func printCommentThreads(issueID string, comments []api.Comment, plaintext bool) {
+	threads := nestComments(comments)
+	if plaintext {
+		for i, comment := range threads {
+			if i > 0 {
+				fmt.Println("---")
+			}
+			printPlainComment(comment, "")
+			if comment.Children != nil {
+				for _, reply := range comment.Children.Nodes {
+					fmt.Println("  Reply:")
+					printPlainComment(reply, "  ")
+				}
+			}
+		}
+		return
+	}
+
+	if len(comments) == 0 {
+		fmt.Printf("\n%s No comments on issue %s\n",
+			color.New(color.FgYellow).Sprint("ℹ️"),
+			color.New(color.FgCyan).Sprint(issueID))
+		return
+	}
+
+	fmt.Printf("\n%s Comments on %s (%d)\n\n",
+		color.New(color.FgCyan, color.Bold).Sprint("💬"),
+		color.New(color.FgCyan).Sprint(issueID),
+		len(comments))
+
+	for i, comment := range threads {
+		if i > 0 {
+			fmt.Println(strings.Repeat("─", 50))
+		}
+		printRichComment(comment, "")
+		if comment.Children != nil {
+			for _, reply := range comment.Children.Nodes {
+				printRichComment(reply, "    ")
+			}
+		}
+	}
+}
+
+func printPlainComment(comment api.Comment, indent string) {
+	fmt.Printf("%sAuthor: %s\n", indent, commentAuthor(&comment))
+	fmt.Printf("%sDate: %s\n", indent, comment.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("%sID: %s\n", indent, comment.ID)
+	if comment.ResolvedAt != nil {
+		fmt.Printf("%sResolved: %s\n", indent, comment.ResolvedAt.Format("2006-01-02 15:04:05"))
+	}
+	fmt.Printf("%sComment:\n%s\n", indent, indentLines(comment.Body, indent))
+	if len(comment.Reactions) > 0 {
+		fmt.Printf("%sReactions: %s\n", indent, reactionSummary(comment.Reactions, true))
+	}
+}
+
+func printRichComment(comment api.Comment, indent string) {
+	marker := ""
+	if indent != "" {
+		marker = color.New(color.FgWhite, color.Faint).Sprint("↳ ")
+	}
+	resolved := ""
+	if comment.ResolvedAt != nil {
+		resolved = " " + color.New(color.FgGreen).Sprint("✓ resolved")
+	}
+	fmt.Printf("%s%s%s %s %s %s%s\n",
+		indent,
+		marker,
+		color.New(color.FgCyan, color.Bold).Sprint(commentAuthor(&comment)),
+		color.New(color.FgWhite, color.Faint).Sprint("•"),
+		color.New(color.FgWhite, color.Faint).Sprint(formatTimeAgo(comment.CreatedAt)),
+		color.New(color.FgWhite, color.Faint).Sprint(comment.ID),
+		resolved)
+	fmt.Printf("\n%s\n", indentLines(comment.Body, indent))
+	if len(comment.Reactions) > 0 {
+		fmt.Printf("%s%s\n", indent, reactionSummary(comment.Reactions, false))
+	}
+	fmt.Println()
+}
+
+// indentLines prefixes every line of text with indent
+func indentLines(text, indent string) string {
+	if indent == "" {
+		return text
+	}
+	return indent + strings.ReplaceAll(text, "\n", "\n"+indent)
+}
+
+// commentAuthor names a comment's author; comments from integrations have no user
+func commentAuthor(comment *api.Comment) string {
+	if comment.User == nil || comment.User.Name == "" {
+		return "Unknown"
+	}
+	return comment.User.Name
+}
+
+// commentExcerpt shortens body to its first line, at most width characters
+func commentExcerpt(body string, width int) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	return output.Truncate(line, width)
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockCommentThreadClient struct {
+	comments map[string]*api.Comment
+	replies  []string
+	resolved []string
+	deleted  []string
+}
+
+func (m *mockCommentThreadClient) Upload(ctx context.Context, path string) (string, error) {
+	return "https://uploads.linear.app/" + path, nil
+}
+
+func (m *mockCommentThreadClient) GetComment(ctx context.Context, id string) (*api.Comment, error) {
+	if comment, ok := m.comments[id]; ok {
+		return comment, nil
+	}
+	return nil, fmt.Errorf("comment %s %w", id, api.ErrNotFound)
+}
+
+func (m *mockCommentThreadClient) CreateCommentReply(ctx context.Context, issueID, parentID, body string) (*api.Comment, error) {
+	m.replies = append(m.replies, issueID+"/"+parentID+": "+body)
+	return &api.Comment{ID: "c-new", Body: body}, nil
+}
+
+func (m *mockCommentThreadClient) UpdateComment(ctx context.Context, id string, body string) (*api.Comment, error) {
+	return &api.Comment{ID: id, Body: body}, nil
+}
+
+func (m *mockCommentThreadClient) DeleteComment(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *mockCommentThreadClient) ResolveComment(ctx context.Context, id string) (*api.Comment, error) {
+	m.resolved = append(m.resolved, id)
+	return m.comments[id], nil
+}
+
+func (m *mockCommentThreadClient) UnresolveComment(ctx context.Context, id string) (*api.Comment, error) {
+	return m.comments[id], nil
+}
+
+func sampleCommentThread() *mockCommentThreadClient {
+	issue := &api.Issue{ID: "issue-1", Identifier: "ENG-1"}
+	return &mockCommentThreadClient{comments: map[string]*api.Comment{
+		"c-root":  {ID: "c-root", Body: "Please add tests", User: &api.User{Name: "Ada"}, Issue: issue},
+		"c-reply": {ID: "c-reply", Body: "On it", User: &api.User{Name: "Bot"}, Issue: issue, Parent: &api.Comment{ID: "c-root"}},
+	}}
+}
+
+func newCommentCommand(t *testing.T, flags map[string]string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("body", "", "")
+	cmd.Flags().String("body-file", "", "")
+	cmd.Flags().Bool("editor", false, "")
+	cmd.Flags().Bool("force", false, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+	return cmd
+}
+
+func TestCommentReplyToReplyStaysInThread(t *testing.T) {
+	mc := sampleCommentThread()
+	out := captureMilestoneStdout(t, func() {
+		runCommentReply(newCommentCommand(t, map[string]string{"body": "Done"}), mc, "c-reply", true, false)
+	})
+
+	if len(mc.replies) != 1 || mc.replies[0] != "issue-1/c-root: Done" {
+		t.Fatalf("replies = %q, want one reply under c-root", mc.replies)
+	}
+	if !contains(out, "Replied on ENG-1") || !contains(out, "Thread: c-root") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestCommentColumnsWide(t *testing.T) {
+	mc := sampleCommentThread()
+	cmd := &cobra.Command{}
+	addColumnFlags(cmd, commentColumns)
+	_ = cmd.Flags().Set("wide", "true")
+	columns, custom, err := commentColumns.parse(cmd)
+	if err != nil || !custom {
+		t.Fatalf("parse: custom=%v err=%v", custom, err)
+	}
+
+	reply := *mc.comments["c-reply"]
+	reply.Reactions = []api.Reaction{{Emoji: "+1"}, {Emoji: "+1"}}
+	table := buildTable(columns, []api.Comment{*mc.comments["c-root"], reply}, false)
+	if got := table.Rows[1][:5]; !reflect.DeepEqual(got, []string{"c-reply", "c-root", "Bot", "On it", "+1 (2)"}) {
+		t.Fatalf("reply row = %q", got)
+	}
+	if table.Rows[0][1] != "c-root" {
+		t.Fatalf("a thread root should be its own thread: %q", table.Rows[0])
+	}
+}
+
+func TestCommentResolveUsesThreadRoot(t *testing.T) {
+	mc := sampleCommentThread()
+	out := captureMilestoneStdout(t, func() {
+		runCommentResolve(mc, "c-reply", true, true, false)
+	})
+
+	if len(mc.resolved) != 1 || mc.resolved[0] != "c-root" {
+		t.Fatalf("resolved %v, want c-root", mc.resolved)
+	}
+	if out != "Resolved thread c-root\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestCommentDeleteDeclined(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, false, &prompts)
+
+	mc := sampleCommentThread()
+	out := captureMilestoneStdout(t, func() {
+		runCommentDelete(newCommentCommand(t, nil), mc, "c-root", true, false)
+	})
+
+	if len(prompts) != 1 || !contains(prompts[0], `Ada's comment "Please add tests"`) {
+		t.Fatalf("prompts = %q", prompts)
+	}
+	if len(mc.deleted) != 0 || !contains(out, "Cancelled.") {
+		t.Fatalf("deleted %v after declining", mc.deleted)
+	}
+}
+
+func TestPrintCommentThreadsNestsReplies(t *testing.T) {
+	at := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	comments := []api.Comment{
+		{ID: "c1", Body: "First", CreatedAt: at, User: &api.User{Name: "Ada"}},
+		{ID: "c2", Body: "Second", CreatedAt: at, User: &api.User{Name: "Grace"}},
+		{ID: "c3", Body: "Reply\nwith two lines", CreatedAt: at, User: &api.User{Name: "Bot"}, Parent: &api.Comment{ID: "c1"}},
+		{ID: "c4", Body: "Orphan", CreatedAt: at, Parent: &api.Comment{ID: "missing"}},
+	}
+
+	out := captureMilestoneStdout(t, func() { printCommentThreads("ENG-1", comments, true) })
+
+	want := "Author: Ada\nDate: 2024-06-01 09:00:00\nID: c1\nComment:\nFirst\n" +
+		"  Reply:\n  Author: Bot\n  Date: 2024-06-01 09:00:00\n  ID: c3\n  Comment:\n  Reply\n  with two lines\n" +
+		"---\nAuthor: Grace\nDate: 2024-06-01 09:00:00\nID: c2\nComment:\nSecond\n" +
+		"---\nAuthor: Unknown\nDate: 2024-06-01 09:00:00\nID: c4\nComment:\nOrphan\n"
+	if out != want {
+		t.Fatalf("output =\n%s\nwant\n%s", out, want)
+	}
+}
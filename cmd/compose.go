@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+// errEmptyText reports that the editor was closed on an empty file
+var errEmptyText = errors.New("nothing was written")
+
+// Injection points for testing
+var composeStdin io.Reader = os.Stdin
+var runEditor = func(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// Allow editors with arguments, such as "code --wait"
+	args := strings.Fields(editor)
+	c := exec.Command(args[0], append(args[1:], path)...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return c.Run()
+}
+
+// composeFlagText returns the text given inline with --<flag>, read from
+// --<fileFlag> ("-" for stdin) or written in $EDITOR with --editor, and
+// whether any of them was used. The editor starts from current, when given.
+// With an uploader, images a file embeds by local path are uploaded and the
+// markdown rewritten to point at them. It exits on conflicting flags or
+// unreadable input, and cancels the command when the editor is closed on an
+// empty file.
+func composeFlagText(cmd *cobra.Command, flag, fileFlag string, current func() string, uploader assetUploader, plaintext, jsonOut bool) (string, bool) {
+	useEditor, _ := cmd.Flags().GetBool("editor")
+
+	var given []string
+	for _, name := range []string{flag, fileFlag} {
+		if cmd.Flags().Changed(name) {
+			given = append(given, "--"+name)
+		}
+	}
+	if useEditor {
+		given = append(given, "--editor")
+	}
+	if len(given) > 1 {
+		output.Error(fmt.Sprintf("Use only one of %s", strings.Join(given, ", ")), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	switch {
+	case cmd.Flags().Changed(fileFlag):
+		path, _ := cmd.Flags().GetString(fileFlag)
+		text, err := readTextFile(path)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read --%s: %v", fileFlag, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		// Uploading is skipped by --dry-run, so the preview shows the local paths
+		if uploader != nil && !dryRunEnabled() {
+			baseDir := "."
+			if path != "-" {
+				baseDir = filepath.Dir(path)
+			}
+			if text, err = uploadMarkdownImages(context.Background(), uploader, text, baseDir); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+		return text, true
+	case useEditor:
+		initial := ""
+		if current != nil {
+			initial = current()
+		}
+		text, err := editText(initial)
+		if errors.Is(err, errEmptyText) {
+			fmt.Fprintf(os.Stderr, "Cancelled: the %s is empty, nothing was sent.\n", flag)
+			os.Exit(0)
+		}
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		return text, true
+	case cmd.Flags().Changed(flag):
+		text, _ := cmd.Flags().GetString(flag)
+		return text, true
+	}
+	return "", false
+}
+
+// readTextFile reads markdown from path, or from stdin when path is "-"
+func readTextFile(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(composeStdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), " \t\r\n"), nil
+}
+
+// editText opens initial in the user's editor as a temporary markdown file
+// and returns what was saved
+func editText(initial string) (string, error) {
+	file, err := os.CreateTemp("", "linctl-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(initial)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := runEditor(file.Name()); err != nil {
+		return "", fmt.Errorf("editor failed: %w", err)
+	}
+
+	text, err := readTextFile(file.Name())
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", errEmptyText
+	}
+	return text, nil
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// withEditor replaces the editor with edit, which rewrites the file's contents
+func withEditor(t *testing.T, edit func(current string) string) {
+	t.Helper()
+	old := runEditor
+	runEditor = func(path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(edit(string(data))), 0o600)
+	}
+	t.Cleanup(func() { runEditor = old })
+}
+
+func newComposeCommand(t *testing.T, flags map[string]string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("description", "", "")
+	cmd.Flags().String("description-file", "", "")
+	cmd.Flags().Bool("editor", false, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+	return cmd
+}
+
+func TestComposeFlagTextFromFileAndStdin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.md")
+	if err := os.WriteFile(path, []byte("# Spec\n\nDetails\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	text, ok := composeFlagText(newComposeCommand(t, map[string]string{"description-file": path}), "description", "description-file", nil, nil, true, false)
+	if !ok || text != "# Spec\n\nDetails" {
+		t.Fatalf("file: got %q, %v", text, ok)
+	}
+
+	old := composeStdin
+	composeStdin = strings.NewReader("from stdin\n")
+	defer func() { composeStdin = old }()
+	text, ok = composeFlagText(newComposeCommand(t, map[string]string{"description-file": "-"}), "description", "description-file", nil, nil, true, false)
+	if !ok || text != "from stdin" {
+		t.Fatalf("stdin: got %q, %v", text, ok)
+	}
+
+	if _, ok := composeFlagText(newComposeCommand(t, nil), "description", "description-file", nil, nil, true, false); ok {
+		t.Fatal("no flags should leave the text unset")
+	}
+}
+
+func TestComposeFlagTextEditorStartsFromCurrent(t *testing.T) {
+	var seen string
+	withEditor(t, func(current string) string {
+		seen = current
+		return current + "\nMore detail\n"
+	})
+
+	cmd := newComposeCommand(t, map[string]string{"editor": "true"})
+	text, ok := composeFlagText(cmd, "description", "description-file", func() string { return "Old text" }, nil, true, false)
+	if seen != "Old text" {
+		t.Fatalf("editor opened with %q, want the current description", seen)
+	}
+	if !ok || text != "Old text\nMore detail" {
+		t.Fatalf("got %q, %v", text, ok)
+	}
+}
+
+func TestEditTextEmptyFileAborts(t *testing.T) {
+	withEditor(t, func(string) string { return "  \n\n" })
+
+	if _, err := editText("draft"); !errors.Is(err, errEmptyText) {
+		t.Fatalf("err = %v, want errEmptyText", err)
+	}
+}
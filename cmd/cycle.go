@@ -0,0 +1,447 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cycleAPI defines the interface for cycle operations
+type cycleAPI interface {
+	GetCycles(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Cycles, error)
+	GetCycle(ctx context.Context, id string) (*api.Cycle, error)
+	GetTeam(ctx context.Context, key string) (*api.Team, error)
+}
+
+// Injection points for testing
+var newCycleAPIClient = func(authHeader string) cycleAPI { return api.NewClient(authHeader) }
+var getCycleAuthHeader = auth.GetAuthHeader
+
+var cycleCmd = &cobra.Command{
+	Use:   "cycle",
+	Short: "View team cycles (sprints)",
+	Long: `List and inspect team cycles, including progress and scope history.
+
+Examples:
+  linctl cycle list --team ENG
+  linctl cycle current --team ENG
+  linctl cycle next --team ENG
+  linctl cycle get 42 --team ENG`,
+}
+
+var cycleListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List cycles",
+	Long:    `List cycles for a team, or for every team when --team is omitted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		columns, _ := selectColumns(cmd, cycleColumns, plaintext, jsonOut)
+
+		client := cycleClient(plaintext, jsonOut)
+		runCycleList(cmd, client, columns, plaintext, jsonOut)
+	},
+}
+
+var cycleGetCmd = &cobra.Command{
+	Use:     "get CYCLE",
+	Aliases: []string{"show"},
+	Short:   "Get cycle details",
+	Long:    `Show a cycle by number (with --team), by ID, or as current, next or previous.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := cycleClient(plaintext, jsonOut)
+		runCycleGet(cmd, client, args[0], plaintext, jsonOut)
+	},
+}
+
+var cycleCurrentCmd = &cobra.Command{
+	Use:   "current",
+	Short: "Show the active cycle",
+	Long:  `Show the team's active cycle.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := cycleClient(plaintext, jsonOut)
+		runCycleGet(cmd, client, "current", plaintext, jsonOut)
+	},
+}
+
+var cycleNextCmd = &cobra.Command{
+	Use:   "next",
+	Short: "Show the next cycle",
+	Long:  `Show the team's upcoming cycle.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := cycleClient(plaintext, jsonOut)
+		runCycleGet(cmd, client, "next", plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cycleCmd)
+	cycleCmd.AddCommand(cycleListCmd)
+	cycleCmd.AddCommand(cycleGetCmd)
+	cycleCmd.AddCommand(cycleCurrentCmd)
+	cycleCmd.AddCommand(cycleNextCmd)
+
+	// List flags
+	cycleListCmd.Flags().StringP("team", "t", "", "Team key")
+	cycleListCmd.Flags().IntP("limit", "l", 50, "Maximum number of cycles to return")
+	addPaginationFlags(cycleListCmd)
+	addColumnFlags(cycleListCmd, cycleColumns)
+
+	for _, cmd := range []*cobra.Command{cycleGetCmd, cycleCurrentCmd, cycleNextCmd} {
+		cmd.Flags().StringP("team", "t", "", "Team key (required unless CYCLE is an ID)")
+	}
+}
+
+// cycleClient authenticates and returns a cycle API client, exiting on failure
+func cycleClient(plaintext, jsonOut bool) cycleAPI {
+	authHeader, err := getCycleAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newCycleAPIClient(authHeader)
+}
+
+// cycleColumns are the table columns available to cycle list
+var cycleColumns = columnSet[api.Cycle]{
+	columns: []tableColumn[api.Cycle]{
+		{Name: "id", Header: "ID", Value: func(c api.Cycle) string { return c.ID }},
+		{Name: "number", Header: "#", Value: func(c api.Cycle) string { return strconv.Itoa(c.Number) }},
+		{Name: "name", Header: "Name", Flex: true, Value: func(c api.Cycle) string { return c.Name }},
+		{Name: "team", Header: "Team", Value: func(c api.Cycle) string {
+			if c.Team == nil {
+				return ""
+			}
+			return c.Team.Key
+		}},
+		{Name: "status", Header: "Status", Value: cycleStatus, Color: cycleStatusColor},
+		{Name: "starts", Header: "Starts", Value: func(c api.Cycle) string { return cycleDate(c.StartsAt) }},
+		{Name: "ends", Header: "Ends", Value: func(c api.Cycle) string { return cycleDate(c.EndsAt) }},
+		{Name: "progress", Header: "Progress", Value: func(c api.Cycle) string { return fmt.Sprintf("%.0f%%", c.Progress*100) }},
+		{Name: "scope", Header: "Scope", Value: func(c api.Cycle) string {
+			if len(c.ScopeHistory) == 0 {
+				return ""
+			}
+			return fmt.Sprintf("%s/%s", formatScope(lastValue(c.CompletedScopeHistory)), formatScope(lastValue(c.ScopeHistory)))
+		}},
+		{Name: "trend", Header: "Scope Trend", Value: func(c api.Cycle) string { return sparkline(c.ScopeHistory) }},
+	},
+	defaults: []string{"number", "name", "team", "status", "starts", "ends", "progress", "scope"},
+	wide:     []string{"id", "number", "name", "team", "status", "starts", "ends", "progress", "scope", "trend"},
+}
+
+func runCycleList(cmd *cobra.Command, client cycleAPI, columns []tableColumn[api.Cycle], plaintext, jsonOut bool) {
+	var filter map[string]interface{}
+	if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+		filter = map[string]interface{}{"team": map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}}
+	}
+
+	pageSize, limit := paginationOptions(cmd)
+	cycles, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+		func(ctx context.Context, first int, after string) ([]api.Cycle, api.PageInfo, error) {
+			// Order explicitly so --limit keeps the earliest cycles; numbers
+			// grow with creation, matching the sort below
+			page, err := client.GetCycles(ctx, filter, first, after, "createdAt")
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list cycles: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if streamed {
+		return
+	}
+
+	if len(cycles) == 0 {
+		output.Info("No cycles found", plaintext, jsonOut)
+		return
+	}
+
+	// Show each team's cycles in chronological order
+	sort.SliceStable(cycles, func(i, j int) bool {
+		ti, tj := "", ""
+		if cycles[i].Team != nil {
+			ti = cycles[i].Team.Key
+		}
+		if cycles[j].Team != nil {
+			tj = cycles[j].Team.Key
+		}
+		if ti != tj {
+			return ti < tj
+		}
+		return cycles[i].Number < cycles[j].Number
+	})
+
+	if jsonOut {
+		output.JSON(cycles)
+		return
+	}
+
+	output.Table(buildTable(columns, cycles, !plaintext), plaintext, jsonOut)
+	if !plaintext {
+		fmt.Printf("\n%s %d cycles\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(cycles))
+		printMoreResultsHint(pageInfo)
+	}
+}
+
+func runCycleGet(cmd *cobra.Command, client cycleAPI, ref string, plaintext, jsonOut bool) {
+	teamKey, _ := cmd.Flags().GetString("team")
+	if teamKey == "" && !isValidUUID(ref) {
+		output.Error("Team is required (--team)", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	var cycle *api.Cycle
+	var err error
+	if isValidUUID(ref) {
+		cycle, err = client.GetCycle(ctx, ref)
+	} else {
+		var team *api.Team
+		team, err = client.GetTeam(ctx, teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		cycle, err = findCycle(ctx, client, team.ID, ref)
+	}
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	renderCycle(cycle, plaintext, jsonOut)
+}
+
+// renderCycle prints a cycle's details with progress and scope history
+func renderCycle(cycle *api.Cycle, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(cycle)
+		return
+	}
+
+	team := ""
+	if cycle.Team != nil {
+		team = fmt.Sprintf("%s (%s)", cycle.Team.Name, cycle.Team.Key)
+	}
+	scope := lastValue(cycle.ScopeHistory)
+	completed := lastValue(cycle.CompletedScopeHistory)
+	issues := lastValue(cycle.IssueCountHistory)
+	completedIssues := lastValue(cycle.CompletedIssueCountHistory)
+
+	if plaintext {
+		fmt.Printf("# %s\n", cycleTitle(*cycle))
+		fmt.Printf("- **ID**: %s\n", cycle.ID)
+		if team != "" {
+			fmt.Printf("- **Team**: %s\n", team)
+		}
+		fmt.Printf("- **Status**: %s\n", cycleStatus(*cycle))
+		fmt.Printf("- **Period**: %s to %s\n", cycleDate(cycle.StartsAt), cycleDate(cycle.EndsAt))
+		fmt.Printf("- **Progress**: %.0f%%\n", cycle.Progress*100)
+		fmt.Printf("- **Scope**: %s (completed %s)\n", formatScope(scope), formatScope(completed))
+		if len(cycle.IssueCountHistory) > 0 {
+			fmt.Printf("- **Issues**: %s (completed %s)\n", formatScope(issues), formatScope(completedIssues))
+		}
+		if len(cycle.ScopeHistory) > 0 {
+			values := make([]string, len(cycle.ScopeHistory))
+			for i, v := range cycle.ScopeHistory {
+				values[i] = formatScope(v)
+			}
+			fmt.Printf("- **Scope History**: %s\n", strings.Join(values, ", "))
+		}
+		if cycle.Description != nil && *cycle.Description != "" {
+			fmt.Printf("\n## Description\n%s\n", *cycle.Description)
+		}
+		return
+	}
+
+	fmt.Printf("\n%s %s\n",
+		color.New(color.FgCyan, color.Bold).Sprint("🔄"),
+		color.New(color.FgCyan, color.Bold).Sprint(cycleTitle(*cycle)))
+	if team != "" {
+		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Team:"), team)
+	}
+	status := cycleStatus(*cycle)
+	if c := cycleStatusColor(*cycle); c != nil {
+		status = c.Sprint(status)
+	}
+	fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), status)
+	fmt.Printf("%s %s → %s\n", color.New(color.Bold).Sprint("Period:"), cycleDate(cycle.StartsAt), cycleDate(cycle.EndsAt))
+
+	progressColor := color.New(color.FgRed)
+	if cycle.Progress >= 0.75 {
+		progressColor = color.New(color.FgGreen)
+	} else if cycle.Progress >= 0.5 {
+		progressColor = color.New(color.FgYellow)
+	}
+	fmt.Printf("%s %s %s\n", color.New(color.Bold).Sprint("Progress:"),
+		progressColor.Sprint(progressBar(cycle.Progress, 20)),
+		progressColor.Sprintf("%.0f%%", cycle.Progress*100))
+
+	fmt.Printf("%s %s of %s completed\n", color.New(color.Bold).Sprint("Scope:"), formatScope(completed), formatScope(scope))
+	if len(cycle.IssueCountHistory) > 0 {
+		fmt.Printf("%s %s of %s completed\n", color.New(color.Bold).Sprint("Issues:"), formatScope(completedIssues), formatScope(issues))
+	}
+	if len(cycle.ScopeHistory) > 1 {
+		fmt.Printf("%s %s  %s → %s\n", color.New(color.Bold).Sprint("Scope history:"),
+			color.New(color.FgBlue).Sprint(sparkline(cycle.ScopeHistory)),
+			formatScope(cycle.ScopeHistory[0]), formatScope(scope))
+	}
+	if cycle.Description != nil && *cycle.Description != "" {
+		fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Description:"), *cycle.Description)
+	}
+}
+
+// cycleFilter turns a cycle reference into a CycleFilter: "current",
+// "next", "previous" or a cycle number
+func cycleFilter(ref string) (map[string]interface{}, error) {
+	switch strings.ToLower(strings.TrimSpace(ref)) {
+	case "current", "active":
+		return map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}, nil
+	case "next":
+		return map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}, nil
+	case "previous", "last":
+		return map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}, nil
+	}
+	number, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(ref), "#"))
+	if err != nil || number <= 0 {
+		return nil, fmt.Errorf("invalid cycle %q (expected current, next, previous or a cycle number)", ref)
+	}
+	return map[string]interface{}{"number": map[string]interface{}{"eq": number}}, nil
+}
+
+// findCycle resolves a cycle reference within a team. IDs are fetched directly.
+func findCycle(ctx context.Context, client cycleAPI, teamID, ref string) (*api.Cycle, error) {
+	if isValidUUID(ref) {
+		return client.GetCycle(ctx, ref)
+	}
+
+	filter, err := cycleFilter(ref)
+	if err != nil {
+		return nil, err
+	}
+	filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": teamID}}
+
+	page, err := client.GetCycles(ctx, filter, 1, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cycle: %w", err)
+	}
+	if len(page.Nodes) == 0 {
+		return nil, fmt.Errorf("cycle %q %w", ref, api.ErrNotFound)
+	}
+	return &page.Nodes[0], nil
+}
+
+// cycleTitle names a cycle by number and, when set, its name
+func cycleTitle(cycle api.Cycle) string {
+	if cycle.Name != "" {
+		return fmt.Sprintf("Cycle %d: %s", cycle.Number, cycle.Name)
+	}
+	return fmt.Sprintf("Cycle %d", cycle.Number)
+}
+
+// cycleStatus describes where a cycle is relative to today
+func cycleStatus(cycle api.Cycle) string {
+	switch {
+	case cycle.IsActive:
+		return "Active"
+	case cycle.IsNext:
+		return "Next"
+	case cycle.CompletedAt != nil:
+		return "Completed"
+	default:
+		return "Upcoming"
+	}
+}
+
+// cycleStatusColor picks the color for a cycle's status
+func cycleStatusColor(cycle api.Cycle) *color.Color {
+	switch {
+	case cycle.IsActive:
+		return color.New(color.FgGreen, color.Bold)
+	case cycle.IsNext:
+		return color.New(color.FgCyan)
+	case cycle.CompletedAt != nil:
+		return color.New(color.FgWhite, color.Faint)
+	default:
+		return nil
+	}
+}
+
+// cycleDate returns the date part of a cycle timestamp
+func cycleDate(timestamp string) string {
+	if len(timestamp) >= 10 {
+		return timestamp[:10]
+	}
+	return timestamp
+}
+
+// formatScope prints a scope value without a trailing ".0"
+func formatScope(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func lastValue(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[len(values)-1]
+}
+
+// sparkline draws values as a row of block characters scaled to their range
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+	blocks := []rune("▁▂▃▄▅▆▇█")
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if hi > lo {
+			i = int((v - lo) / (hi - lo) * float64(len(blocks)-1))
+		}
+		b.WriteRune(blocks[i])
+	}
+	return b.String()
+}
+
+// progressBar draws a fraction between 0 and 1 as a bar of the given width
+func progressBar(fraction float64, width int) string {
+	filled := int(fraction*float64(width) + 0.5)
+	filled = max(0, min(width, filled))
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
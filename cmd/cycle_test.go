@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type mockCycleClient struct {
+	cycles   []api.Cycle
+	filters  []map[string]interface{}
+	orderBys []string
+}
+
+func (m *mockCycleClient) GetCycles(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Cycles, error) {
+	m.filters = append(m.filters, filter)
+	m.orderBys = append(m.orderBys, orderBy)
+	var nodes []api.Cycle
+	for _, cycle := range m.cycles {
+		if f, ok := filter["isActive"]; ok && f != nil && !cycle.IsActive {
+			continue
+		}
+		if f, ok := filter["isNext"]; ok && f != nil && !cycle.IsNext {
+			continue
+		}
+		if f, ok := filter["number"].(map[string]interface{}); ok && f["eq"] != cycle.Number {
+			continue
+		}
+		nodes = append(nodes, cycle)
+	}
+	return &api.Cycles{Nodes: nodes}, nil
+}
+
+func (m *mockCycleClient) GetCycle(ctx context.Context, id string) (*api.Cycle, error) {
+	for _, cycle := range m.cycles {
+		if cycle.ID == id {
+			return &cycle, nil
+		}
+	}
+	return nil, api.ErrNotFound
+}
+
+func (m *mockCycleClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
+	return &api.Team{ID: "team-eng", Key: key, Name: "Engineering"}, nil
+}
+
+func withInjectedCycleClient(t *testing.T, mc *mockCycleClient, fn func()) {
+	t.Helper()
+	oldNew := newCycleAPIClient
+	oldAuth := getCycleAuthHeader
+	newCycleAPIClient = func(_ string) cycleAPI { return mc }
+	getCycleAuthHeader = func() (string, error) { return "Bearer test", nil }
+	defer func() { newCycleAPIClient = oldNew; getCycleAuthHeader = oldAuth }()
+	fn()
+}
+
+func sampleCycles() []api.Cycle {
+	team := &api.Team{ID: "team-eng", Key: "ENG", Name: "Engineering"}
+	return []api.Cycle{
+		{ID: "cycle-11", Number: 11, Team: team, StartsAt: "2024-05-20T00:00:00.000Z", EndsAt: "2024-06-03T00:00:00.000Z"},
+		{ID: "cycle-12", Number: 12, Name: "Checkout", Team: team, IsActive: true, Progress: 0.5,
+			StartsAt: "2024-06-03T00:00:00.000Z", EndsAt: "2024-06-17T00:00:00.000Z",
+			ScopeHistory: []float64{10, 12, 16}, CompletedScopeHistory: []float64{0, 4, 8}},
+		{ID: "cycle-13", Number: 13, Team: team, IsNext: true},
+	}
+}
+
+func TestCycleFilter(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want map[string]interface{}
+	}{
+		{"current", map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}},
+		{"Next", map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}},
+		{"previous", map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}},
+		{"#42", map[string]interface{}{"number": map[string]interface{}{"eq": 42}}},
+	}
+	for _, tt := range tests {
+		got, err := cycleFilter(tt.ref)
+		if err != nil {
+			t.Fatalf("cycleFilter(%q) error = %v", tt.ref, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("cycleFilter(%q) = %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+
+	if _, err := cycleFilter("soon"); err == nil {
+		t.Fatal("cycleFilter(\"soon\") should fail")
+	}
+}
+
+func TestFindCycle(t *testing.T) {
+	mc := &mockCycleClient{cycles: sampleCycles()}
+
+	cycle, err := findCycle(context.Background(), mc, "team-eng", "current")
+	if err != nil || cycle.ID != "cycle-12" {
+		t.Fatalf("findCycle(current) = %v, %v", cycle, err)
+	}
+	team := mc.filters[0]["team"].(map[string]interface{})["id"].(map[string]interface{})["eq"]
+	if team != "team-eng" {
+		t.Fatalf("filter team = %v, want team-eng", team)
+	}
+
+	cycle, err = findCycle(context.Background(), mc, "team-eng", "11")
+	if err != nil || cycle.ID != "cycle-11" {
+		t.Fatalf("findCycle(11) = %v, %v", cycle, err)
+	}
+
+	_, err = findCycle(context.Background(), mc, "team-eng", "99")
+	if !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("findCycle(99) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCycleListLimitFollowsCreationOrder(t *testing.T) {
+	mc := &mockCycleClient{cycles: sampleCycles()}
+	cmd := &cobra.Command{}
+	cmd.Flags().String("team", "", "")
+	cmd.Flags().Int("limit", 2, "")
+	addPaginationFlags(cmd)
+
+	out := captureMilestoneStdout(t, func() {
+		runCycleList(cmd, mc, cycleColumns.columns, false, true)
+	})
+
+	if !reflect.DeepEqual(mc.orderBys, []string{"createdAt"}) {
+		t.Fatalf("orderBy = %q, want createdAt", mc.orderBys)
+	}
+	if !contains(out, `"cycle-11"`) || !contains(out, `"cycle-12"`) || contains(out, `"cycle-13"`) {
+		t.Fatalf("want the first two cycles:\n%s", out)
+	}
+}
+
+func TestCycleCurrentPlaintext(t *testing.T) {
+	mc := &mockCycleClient{cycles: sampleCycles()}
+	withInjectedCycleClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		defer func() { _ = cycleCurrentCmd.Flags().Set("team", "") }()
+		_ = cycleCurrentCmd.Flags().Set("team", "ENG")
+		out := captureMilestoneStdout(t, func() {
+			cycleCurrentCmd.Run(cycleCurrentCmd, nil)
+		})
+		for _, want := range []string{"# Cycle 12: Checkout", "**Status**: Active", "**Period**: 2024-06-03 to 2024-06-17", "**Scope**: 16 (completed 8)", "**Scope History**: 10, 12, 16"} {
+			if !contains(out, want) {
+				t.Fatalf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
+
+func TestBuildIssueFilterCycle(t *testing.T) {
+	cmd := &cobra.Command{Use: "list"}
+	cmd.Flags().String("cycle", "", "")
+	cmd.Flags().Int("priority", -1, "")
+	_ = cmd.Flags().Set("cycle", "none")
+	filter := buildIssueFilter(cmd)
+	if !reflect.DeepEqual(filter["cycle"], map[string]interface{}{"null": true}) {
+		t.Fatalf("cycle filter = %v, want null", filter["cycle"])
+	}
+
+	_ = cmd.Flags().Set("cycle", "current")
+	filter = buildIssueFilter(cmd)
+	if !reflect.DeepEqual(filter["cycle"], map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}) {
+		t.Fatalf("cycle filter = %v, want isActive", filter["cycle"])
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]float64{1, 5, 9}); got != "▁▄█" {
+		t.Fatalf("sparkline() = %q", got)
+	}
+	if got := sparkline([]float64{3, 3}); got != "▁▁" {
+		t.Fatalf("sparkline() of flat values = %q", got)
+	}
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+// dryRunState collects mutations intercepted by --dry-run
+var dryRunState struct {
+	sync.Mutex
+	batch     bool
+	mutations []api.MutationPreview
+}
+
+// previewMutation is installed as api.DryRun by --dry-run. A single
+// mutation is printed and the process exits before the command would report
+// success; commands sending several mutations call startDryRunBatch first,
+// skip api.ErrDryRun, and print them all with finishDryRun.
+func previewMutation(preview api.MutationPreview) error {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+
+	dryRunState.mutations = append(dryRunState.mutations, preview)
+	if dryRunState.batch {
+		return nil
+	}
+	printDryRun(dryRunState.mutations, viper.GetBool("plaintext"), viper.GetBool("json"))
+	os.Exit(0)
+	return nil
+}
+
+// startDryRunBatch makes previewMutation collect mutations instead of exiting
+func startDryRunBatch() {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+	dryRunState.batch = true
+}
+
+// finishDryRun prints the mutations collected since startDryRunBatch. It
+// returns false when --dry-run is off and the command should report its
+// results as usual.
+func finishDryRun(plaintext, jsonOut bool) bool {
+	if api.DryRun == nil {
+		return false
+	}
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+	printDryRun(dryRunState.mutations, plaintext, jsonOut)
+	return true
+}
+
+// dryRunEnabled reports whether mutations are being intercepted
+func dryRunEnabled() bool {
+	return api.DryRun != nil
+}
+
+func printDryRun(mutations []api.MutationPreview, plaintext, jsonOut bool) {
+	if jsonOut {
+		if mutations == nil {
+			mutations = []api.MutationPreview{}
+		}
+		output.JSON(map[string]interface{}{
+			"dryRun":    true,
+			"mutations": mutations,
+		})
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("Dry run: %d mutation(s) not sent\n", len(mutations))
+	} else {
+		fmt.Printf("%s Dry run: %d mutation(s) not sent\n",
+			color.New(color.FgYellow).Sprint("⚠"),
+			len(mutations))
+	}
+	for _, mutation := range mutations {
+		variables, _ := json.MarshalIndent(mutation.Variables, "", "  ")
+		if plaintext {
+			fmt.Printf("\n## %s\n%s\n\nVariables:\n%s\n", mutation.Operation, mutation.Query, variables)
+			continue
+		}
+		fmt.Printf("\n%s\n%s\n%s\n%s\n",
+			color.New(color.FgCyan, color.Bold).Sprint(mutation.Operation),
+			color.New(color.FgWhite, color.Faint).Sprint(mutation.Query),
+			color.New(color.FgYellow).Sprint("Variables:"),
+			variables)
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+func TestDryRunBatchCollectsMutations(t *testing.T) {
+	api.DryRun = previewMutation
+	defer func() {
+		api.DryRun = nil
+		dryRunState.batch = false
+		dryRunState.mutations = nil
+	}()
+
+	startDryRunBatch()
+	for _, id := range []string{"id-1", "id-2"} {
+		if err := previewMutation(api.MutationPreview{
+			Operation: "UpdateIssue",
+			Query:     "mutation UpdateIssue($id: String!) { issueUpdate(id: $id) { success } }",
+			Variables: map[string]interface{}{"id": id},
+		}); err != nil {
+			t.Fatalf("previewMutation: %v", err)
+		}
+	}
+
+	out := captureMilestoneStdout(t, func() {
+		if !finishDryRun(false, true) {
+			t.Fatal("finishDryRun should report dry-run mode")
+		}
+	})
+
+	var preview struct {
+		DryRun    bool                  `json:"dryRun"`
+		Mutations []api.MutationPreview `json:"mutations"`
+	}
+	if err := json.Unmarshal([]byte(out), &preview); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if !preview.DryRun || len(preview.Mutations) != 2 || preview.Mutations[1].Variables["id"] != "id-2" {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+}
+
+func TestFinishDryRunOff(t *testing.T) {
+	if finishDryRun(true, false) {
+		t.Fatal("finishDryRun should return false without --dry-run")
+	}
+}
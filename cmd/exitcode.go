@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+// Process exit codes. These are part of linctl's scripting contract and
+// must not be renumbered.
+const (
+	exitError           = 1
+	exitUsage           = 2
+	exitNotFound        = 3
+	exitUnauthenticated = 4
+	exitForbidden       = 5
+	exitRateLimited     = 6
+	exitValidation      = 7
+)
+
+// exitCode maps an error to the process exit code scripts can rely on
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitError
+	case errors.Is(err, api.ErrNotFound):
+		return exitNotFound
+	case errors.Is(err, api.ErrUnauthenticated):
+		return exitUnauthenticated
+	case errors.Is(err, api.ErrForbidden):
+		return exitForbidden
+	case errors.Is(err, api.ErrRateLimited):
+		return exitRateLimited
+	case errors.Is(err, api.ErrValidation):
+		return exitValidation
+	default:
+		return exitError
+	}
+}
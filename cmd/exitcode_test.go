@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+func TestExitCode(t *testing.T) {
+	cases := []struct {
+		in   error
+		want int
+	}{
+		{nil, exitError},
+		{errors.New("boom"), exitError},
+		{fmt.Errorf("wrapped: %w", api.ErrNotFound), exitNotFound},
+		{api.ErrUnauthenticated, exitUnauthenticated},
+		{&api.HTTPError{StatusCode: 403}, exitForbidden},
+		{api.GraphQLErrors{{Message: "slow down", Extensions: &api.GraphQLErrorExtensions{Code: "RATELIMITED"}}}, exitRateLimited},
+		{api.GraphQLErrors{{Message: "bad", Extensions: &api.GraphQLErrorExtensions{Code: "INVALID_INPUT"}}}, exitValidation},
+	}
+	for _, c := range cases {
+		if got := exitCode(c.in); got != c.want {
+			t.Errorf("exitCode(%v) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dorkitude/linctl/pkg/api"
@@ -20,15 +22,10 @@ var uuidRegexp = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{
 
 func isValidUUID(s string) bool { return uuidRegexp.MatchString(s) }
 
+// isProjectNotFoundErr reports whether err is a not-found error that names a
+// project as the missing entity
 func isProjectNotFoundErr(err error) bool {
-	if err == nil {
-		return false
-	}
-	e := strings.ToLower(err.Error())
-	if !strings.Contains(e, "not found") {
-		return false
-	}
-	return strings.Contains(e, "project") || strings.Contains(e, "projectid")
+	return errors.Is(err, api.ErrNotFound) && api.NotFoundEntity(err) == "Project"
 }
 
 // buildProjectInput normalizes a --project flag value to a GraphQL input value.
@@ -61,6 +58,7 @@ Examples:
   linctl issue ls -a me -s "In Progress"
   linctl issue list --include-completed  # Show all issues including completed
   linctl issue list --newer-than 3_weeks_ago  # Show issues from last 3 weeks
+  linctl issue list --team ENG --cycle current  # Issues in the active cycle
   linctl issue search "login bug" --team ENG
   linctl issue get LIN-123
   linctl issue create --title "Bug fix" --team ENG`,
@@ -74,22 +72,21 @@ var issueListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		columns, customColumns := selectColumns(cmd, issueColumns, plaintext, jsonOut)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
 
 		// Build filter from flags
 		filter := buildIssueFilter(cmd)
+		archived, _ := cmd.Flags().GetBool("archived")
 
-		limit, _ := cmd.Flags().GetInt("limit")
-		if limit == 0 {
-			limit = 50
-		}
+		pageSize, limit := paginationOptions(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -105,21 +102,32 @@ var issueListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
-		issues, err := client.GetIssues(context.Background(), filter, limit, "", orderBy)
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Issue, api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, filter, first, after, orderBy, archived)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		if streamed {
+			return
+		}
+		issues := &api.Issues{Nodes: nodes, PageInfo: pageInfo}
 
-		renderIssueCollection(issues, plaintext, jsonOut, "No issues found", "issues", "# Issues")
+		renderIssueCollection(issues, columns, customColumns, plaintext, jsonOut, "No issues found", "issues", "# Issues")
 	},
 }
 
-func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string) {
+func renderIssueCollection(issues *api.Issues, columns []tableColumn[api.Issue], customColumns, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string) {
 	if len(issues.Nodes) == 0 {
 		output.Info(emptyMessage, plaintext, jsonOut)
 		return
@@ -130,6 +138,11 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		return
 	}
 
+	if plaintext && customColumns {
+		output.Table(buildTable(columns, issues.Nodes, false), true, false)
+		return
+	}
+
 	if plaintext {
 		fmt.Println(plaintextTitle)
 		for _, issue := range issues.Nodes {
@@ -160,78 +173,120 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		return
 	}
 
-	headers := []string{"Title", "State", "Assignee", "Team", "Project", "Created", "URL"}
-	rows := make([][]string, len(issues.Nodes))
-
-	for i, issue := range issues.Nodes {
-		assignee := "Unassigned"
-		if issue.Assignee != nil {
-			assignee = issue.Assignee.Name
-		}
-
-		team := ""
-		if issue.Team != nil {
-			team = issue.Team.Key
-		}
-
-		project := ""
-		if issue.Project != nil {
-			project = truncateString(issue.Project.Name, 25)
-		}
-
-		state := ""
-		if issue.State != nil {
-			state = issue.State.Name
-			var stateColor *color.Color
-			switch issue.State.Type {
-			case "triage":
-				stateColor = color.New(color.FgMagenta)
-			case "backlog":
-				stateColor = color.New(color.FgCyan)
-			case "unstarted":
-				stateColor = color.New(color.FgWhite)
-			case "started":
-				stateColor = color.New(color.FgBlue)
-			case "completed":
-				stateColor = color.New(color.FgGreen)
-			case "canceled":
-				stateColor = color.New(color.FgRed)
-			default:
-				stateColor = color.New(color.FgWhite)
-			}
-			state = stateColor.Sprint(state)
-		}
-
-		if issue.Assignee == nil {
-			assignee = color.New(color.FgYellow).Sprint(assignee)
-		}
-
-		rows[i] = []string{
-			truncateString(issue.Title, 40),
-			state,
-			assignee,
-			team,
-			project,
-			issue.CreatedAt.Format("2006-01-02"),
-			issue.URL,
-		}
-	}
-
-	tableData := output.TableData{
-		Headers: headers,
-		Rows:    rows,
-	}
-
-	output.Table(tableData, false, false)
+	output.Table(buildTable(columns, issues.Nodes, true), false, false)
 
 	fmt.Printf("\n%s %d %s\n",
 		color.New(color.FgGreen).Sprint("✓"),
 		len(issues.Nodes),
 		summaryLabel)
 
-	if issues.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
-			color.New(color.FgYellow).Sprint("ℹ️"))
+	printMoreResultsHint(issues.PageInfo)
+}
+
+// issueColumns are the table columns available to issue list and search
+var issueColumns = columnSet[api.Issue]{
+	columns: []tableColumn[api.Issue]{
+		{Name: "identifier", Header: "ID", Value: func(i api.Issue) string { return i.Identifier },
+			Color: func(api.Issue) *color.Color { return color.New(color.FgCyan) }},
+		{Name: "title", Header: "Title", Flex: true, Value: func(i api.Issue) string { return i.Title }},
+		{Name: "state", Header: "State", Color: issueStateColor, Value: func(i api.Issue) string {
+			if i.State == nil {
+				return ""
+			}
+			return i.State.Name
+		}},
+		{Name: "assignee", Header: "Assignee", Value: func(i api.Issue) string {
+			if i.Assignee == nil {
+				return "Unassigned"
+			}
+			return i.Assignee.Name
+		}, Color: func(i api.Issue) *color.Color {
+			if i.Assignee == nil {
+				return color.New(color.FgYellow)
+			}
+			return nil
+		}},
+		{Name: "team", Header: "Team", Value: func(i api.Issue) string {
+			if i.Team == nil {
+				return ""
+			}
+			return i.Team.Key
+		}},
+		{Name: "project", Header: "Project", Flex: true, Value: func(i api.Issue) string {
+			if i.Project == nil {
+				return ""
+			}
+			return i.Project.Name
+		}},
+		{Name: "priority", Header: "Priority", Value: func(i api.Issue) string {
+			if i.PriorityLabel != "" {
+				return i.PriorityLabel
+			}
+			return priorityToString(i.Priority)
+		}},
+		{Name: "estimate", Header: "Estimate", Value: func(i api.Issue) string {
+			if i.Estimate == nil {
+				return ""
+			}
+			return strconv.FormatFloat(*i.Estimate, 'f', -1, 64)
+		}},
+		{Name: "labels", Header: "Labels", Flex: true, Value: func(i api.Issue) string {
+			if i.Labels == nil {
+				return ""
+			}
+			names := make([]string, len(i.Labels.Nodes))
+			for j, label := range i.Labels.Nodes {
+				names[j] = label.Name
+			}
+			return strings.Join(names, ", ")
+		}},
+		{Name: "cycle", Header: "Cycle", Value: func(i api.Issue) string {
+			if i.Cycle == nil {
+				return ""
+			}
+			if i.Cycle.Name != "" {
+				return i.Cycle.Name
+			}
+			return fmt.Sprintf("Cycle %d", i.Cycle.Number)
+		}},
+		{Name: "due", Header: "Due", Value: func(i api.Issue) string {
+			if i.DueDate == nil {
+				return ""
+			}
+			return *i.DueDate
+		}},
+		{Name: "created", Header: "Created", Value: func(i api.Issue) string { return i.CreatedAt.Format("2006-01-02") }},
+		{Name: "updated", Header: "Updated", Value: func(i api.Issue) string { return i.UpdatedAt.Format("2006-01-02") }},
+		{Name: "archived", Header: "Archived", Value: func(i api.Issue) string {
+			if i.ArchivedAt == nil {
+				return ""
+			}
+			return i.ArchivedAt.Format("2006-01-02")
+		}},
+		{Name: "url", Header: "URL", Value: func(i api.Issue) string { return i.URL }},
+	},
+	defaults: []string{"title", "state", "assignee", "team", "project", "created", "url"},
+	wide:     []string{"identifier", "title", "state", "priority", "estimate", "assignee", "team", "project", "labels", "cycle", "due", "created", "url"},
+}
+
+// issueStateColor picks the color for an issue's workflow state type
+func issueStateColor(issue api.Issue) *color.Color {
+	if issue.State == nil {
+		return nil
+	}
+	switch issue.State.Type {
+	case "triage":
+		return color.New(color.FgMagenta)
+	case "backlog":
+		return color.New(color.FgCyan)
+	case "started":
+		return color.New(color.FgBlue)
+	case "completed":
+		return color.New(color.FgGreen)
+	case "canceled":
+		return color.New(color.FgRed)
+	default:
+		return color.New(color.FgWhite)
 	}
 }
 
@@ -253,23 +308,21 @@ Examples:
 		query := strings.TrimSpace(strings.Join(args, " "))
 		if query == "" {
 			output.Error("Search query is required", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
+		columns, customColumns := selectColumns(cmd, issueColumns, plaintext, jsonOut)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
 
 		filter := buildIssueFilter(cmd)
 
-		limit, _ := cmd.Flags().GetInt("limit")
-		if limit == 0 {
-			limit = 50
-		}
+		pageSize, limit := paginationOptions(cmd)
 
 		sortBy, _ := cmd.Flags().GetString("sort")
 		orderBy := ""
@@ -283,20 +336,31 @@ Examples:
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
 		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 
-		issues, err := client.IssueSearch(context.Background(), query, filter, limit, "", orderBy, includeArchived)
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Issue, api.PageInfo, error) {
+				page, err := client.IssueSearch(ctx, query, filter, first, after, orderBy, includeArchived)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		if streamed {
+			return
+		}
+		issues := &api.Issues{Nodes: nodes, PageInfo: pageInfo}
 
 		emptyMsg := fmt.Sprintf("No matches found for %q", query)
-		renderIssueCollection(issues, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
+		renderIssueCollection(issues, columns, customColumns, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
 	},
 }
 
@@ -313,14 +377,14 @@ var issueGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
 		issue, err := client.GetIssue(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -459,39 +523,13 @@ var issueGetCmd = &cobra.Command{
 			}
 
 			// Relations
-			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
-				fmt.Printf("\n## Related Issues\n")
-				for _, relation := range issue.Relations.Nodes {
-					if relation.RelatedIssue != nil {
-						relationType := relation.Type
-						switch relationType {
-						case "blocks":
-							relationType = "Blocks"
-						case "blocked":
-							relationType = "Blocked by"
-						case "related":
-							relationType = "Related to"
-						case "duplicate":
-							relationType = "Duplicate of"
-						}
-						fmt.Printf("- %s: %s - %s", relationType, relation.RelatedIssue.Identifier, relation.RelatedIssue.Title)
-						if relation.RelatedIssue.State != nil {
-							fmt.Printf(" [%s]", relation.RelatedIssue.State.Name)
-						}
-						fmt.Println()
-					}
-				}
-			}
+			printIssueDependencies(issue, true)
 
 			// Reactions
 			if len(issue.Reactions) > 0 {
 				fmt.Printf("\n## Reactions\n")
-				reactionMap := make(map[string][]string)
-				for _, reaction := range issue.Reactions {
-					reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], reaction.User.Name)
-				}
-				for emoji, users := range reactionMap {
-					fmt.Printf("- %s: %s\n", emoji, strings.Join(users, ", "))
+				for _, group := range groupReactions(issue.Reactions) {
+					fmt.Printf("- %s: %s\n", group.Emoji, strings.Join(group.Users, ", "))
 				}
 			}
 
@@ -505,21 +543,7 @@ var issueGetCmd = &cobra.Command{
 			if issue.Children != nil && len(issue.Children.Nodes) > 0 {
 				fmt.Printf("\n## Sub-issues\n")
 				for _, child := range issue.Children.Nodes {
-					stateStr := ""
-					if child.State != nil {
-						switch child.State.Type {
-						case "completed", "done":
-							stateStr = "[x]"
-						case "started", "in_progress":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
-					}
+					stateStr := issueStateMarker(child.State)
 
 					assignee := "Unassigned"
 					if child.Assignee != nil {
@@ -597,6 +621,7 @@ var issueGetCmd = &cobra.Command{
 					}
 					fmt.Println()
 				}
+				fmt.Printf("\n> Use `linctl issue history %s` to see the full history\n", issue.Identifier)
 			}
 
 			return
@@ -686,17 +711,7 @@ var issueGetCmd = &cobra.Command{
 		if issue.Children != nil && len(issue.Children.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
 			for _, child := range issue.Children.Nodes {
-				stateIcon := "○"
-				if child.State != nil {
-					switch child.State.Type {
-					case "completed", "done":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
-					case "started", "in_progress":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
-					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
-					}
-				}
+				stateIcon := issueStateIcon(child.State)
 
 				assignee := "Unassigned"
 				if child.Assignee != nil {
@@ -711,6 +726,13 @@ var issueGetCmd = &cobra.Command{
 			}
 		}
 
+		// Show blockers and other relations
+		printIssueDependencies(issue, false)
+
+		if len(issue.Reactions) > 0 {
+			fmt.Printf("\n%s %s\n", color.New(color.FgYellow).Sprint("Reactions:"), reactionSummary(issue.Reactions, false))
+		}
+
 		// Show attachments if any
 		if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Attachments:"))
@@ -758,10 +780,17 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		}
 	}
 
+	// Archived issues are usually done, so --archived also lifts the
+	// completed filter
+	archived, _ := cmd.Flags().GetBool("archived")
+	if archived {
+		filter["archivedAt"] = map[string]interface{}{"null": false}
+	}
+
 	state, _ := cmd.Flags().GetString("state")
 	if state != "" {
 		filter["state"] = map[string]interface{}{"name": map[string]interface{}{"eq": state}}
-	} else {
+	} else if !archived {
 		// Only filter out completed issues if no specific state is requested
 		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
 		if !includeCompleted {
@@ -782,6 +811,36 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		filter["priority"] = map[string]interface{}{"eq": priority}
 	}
 
+	// Each --label must be present on the issue
+	if labels, _ := cmd.Flags().GetStringSlice("label"); len(labels) > 0 {
+		var conditions []interface{}
+		for _, label := range labels {
+			conditions = append(conditions, map[string]interface{}{
+				"labels": map[string]interface{}{
+					"some": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": label}},
+				},
+			})
+		}
+		if len(conditions) == 1 {
+			filter["labels"] = conditions[0].(map[string]interface{})["labels"]
+		} else {
+			filter["and"] = conditions
+		}
+	}
+
+	if cycle, _ := cmd.Flags().GetString("cycle"); cycle != "" {
+		if strings.EqualFold(cycle, "none") {
+			filter["cycle"] = map[string]interface{}{"null": true}
+		} else {
+			byCycle, err := cycleFilter(cycle)
+			if err != nil {
+				output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+				os.Exit(exitUsage)
+			}
+			filter["cycle"] = byCycle
+		}
+	}
+
 	// Handle newer-than filter
 	newerThan, _ := cmd.Flags().GetString("newer-than")
 	createdAt, err := utils.ParseTimeExpression(newerThan)
@@ -789,7 +848,7 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitUsage)
 	}
 	if createdAt != "" {
 		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -815,11 +874,9 @@ func priorityToString(priority int) string {
 	}
 }
 
+// truncateString shortens s to maxLen terminal cells without splitting characters
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen-3] + "..."
+	return output.Truncate(s, maxLen)
 }
 
 var issueAssignCmd = &cobra.Command{
@@ -834,7 +891,7 @@ var issueAssignCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
@@ -843,7 +900,7 @@ var issueAssignCmd = &cobra.Command{
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Update issue with assignee
@@ -854,7 +911,7 @@ var issueAssignCmd = &cobra.Command{
 		issue, err := client.UpdateIssue(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -882,33 +939,50 @@ var issueCreateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
 
 		// Get flags
 		title, _ := cmd.Flags().GetString("title")
-		description, _ := cmd.Flags().GetString("description")
 		teamKey, _ := cmd.Flags().GetString("team")
 		priority, _ := cmd.Flags().GetInt("priority")
 		assignToMe, _ := cmd.Flags().GetBool("assign-me")
 
 		if title == "" {
 			output.Error("Title is required (--title)", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
+		}
+
+		// A sub-issue defaults to its parent's team
+		var parent *api.Issue
+		if parentRef, _ := cmd.Flags().GetString("parent"); parentRef != "" {
+			parent, err = client.GetIssue(context.Background(), parentRef)
+			if err == nil && parent.ID == "" {
+				err = fmt.Errorf("issue %q %w", parentRef, api.ErrNotFound)
+			}
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find parent issue '%s': %v", parentRef, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
 		}
 
-		if teamKey == "" {
+		if teamKey == "" && (parent == nil || parent.Team == nil) {
 			output.Error("Team is required (--team)", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		// Get team ID from key
-		team, err := client.GetTeam(context.Background(), teamKey)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-			os.Exit(1)
+		var team *api.Team
+		if teamKey != "" {
+			team, err = client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		} else {
+			team = parent.Team
 		}
 
 		// Build input
@@ -917,8 +991,8 @@ var issueCreateCmd = &cobra.Command{
 			"teamId": team.ID,
 		}
 
-		if description != "" {
-			input["description"] = description
+		if parent != nil {
+			input["parentId"] = parent.ID
 		}
 
 		if priority >= 0 && priority <= 4 {
@@ -929,17 +1003,27 @@ var issueCreateCmd = &cobra.Command{
 			viewer, err := client.GetViewer(context.Background())
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			input["assigneeId"] = viewer.ID
 		}
 
+		// Handle labels
+		if labels, _ := cmd.Flags().GetStringSlice("label"); len(labels) > 0 {
+			labelIDs, err := resolveLabelIDs(context.Background(), client, team.ID, labels)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input["labelIds"] = labelIDs
+		}
+
 		// Handle project assignment
 		if cmd.Flags().Changed("project") {
 			projectID, _ := cmd.Flags().GetString("project")
 			if val, ok, err := buildProjectInput(projectID); err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			} else if ok {
 				// For create, "unassigned" is equivalent to not setting project
 				if val != nil {
@@ -948,6 +1032,12 @@ var issueCreateCmd = &cobra.Command{
 			}
 		}
 
+		// Compose the description last, once every other flag is known to be
+		// good, so a usage error does not discard editor text or orphan uploads
+		if description, _ := composeFlagText(cmd, "description", "description-file", nil, client, plaintext, jsonOut); description != "" {
+			input["description"] = description
+		}
+
 		// Create issue
 		issue, err := client.CreateIssue(context.Background(), input)
 		if err != nil {
@@ -956,11 +1046,11 @@ var issueCreateCmd = &cobra.Command{
 				projectID, _ := cmd.Flags().GetString("project")
 				if projectID != "" && projectID != "unassigned" && isProjectNotFoundErr(err) {
 					output.Error(fmt.Sprintf("Project '%s' not found", projectID), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitNotFound)
 				}
 			}
 			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -970,6 +1060,9 @@ var issueCreateCmd = &cobra.Command{
 			if issue.Project != nil {
 				fmt.Printf("Project: %s\n", issue.Project.Name)
 			}
+			if parent != nil {
+				fmt.Printf("Parent: %s\n", parent.Identifier)
+			}
 		} else {
 			fmt.Printf("%s Created issue %s: %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -981,6 +1074,9 @@ var issueCreateCmd = &cobra.Command{
 			if issue.Project != nil {
 				fmt.Printf("  Project: %s\n", color.New(color.FgBlue).Sprint(issue.Project.Name))
 			}
+			if parent != nil {
+				fmt.Printf("  Sub-issue of: %s %s\n", color.New(color.FgCyan).Sprint(parent.Identifier), parent.Title)
+			}
 		}
 	},
 }
@@ -999,7 +1095,12 @@ Examples:
   linctl issue update LIN-123 --due-date "2024-12-31"
   linctl issue update LIN-123 --parent LIN-100     # Make sub-issue of LIN-100
   linctl issue update LIN-123 --parent none        # Remove parent (promote to top-level)
-  linctl issue update LIN-123 --title "New title" --assignee me --priority 2`,
+  linctl issue update LIN-123 --add-label bug --remove-label triage
+  linctl issue update LIN-123 --cycle next       # Move to the next cycle
+  linctl issue update LIN-123 --label "Type/Bug,frontend"  # Replace all labels
+  linctl issue update LIN-123 --title "New title" --assignee me --priority 2
+  linctl issue update LIN-123 --description-file spec.md
+  linctl issue update LIN-123 --editor           # Edit the description in $EDITOR`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -1008,11 +1109,25 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
 
+		// currentIssue fetches the issue being updated, at most once
+		var existing *api.Issue
+		currentIssue := func() *api.Issue {
+			if existing == nil {
+				issue, err := client.GetIssue(context.Background(), args[0])
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				existing = issue
+			}
+			return existing
+		}
+
 		// Build update input
 		input := make(map[string]interface{})
 
@@ -1022,88 +1137,25 @@ Examples:
 			input["title"] = title
 		}
 
-		// Handle description update
-		if cmd.Flags().Changed("description") {
-			description, _ := cmd.Flags().GetString("description")
-			input["description"] = description
-		}
-
 		// Handle assignee update
 		if cmd.Flags().Changed("assignee") {
 			assignee, _ := cmd.Flags().GetString("assignee")
-			switch assignee {
-			case "me":
-				// Get current user
-				viewer, err := client.GetViewer(context.Background())
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["assigneeId"] = viewer.ID
-			case "unassigned", "":
-				input["assigneeId"] = nil
-			default:
-				// Look up user by email
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.Email == assignee || user.Name == assignee {
-						foundUser = &user
-						break
-					}
-				}
-
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				input["assigneeId"] = foundUser.ID
+			assigneeID, err := resolveAssigneeID(context.Background(), client, assignee)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
 			}
+			input["assigneeId"] = assigneeID
 		}
 
 		// Handle state update
 		if cmd.Flags().Changed("state") {
 			stateName, _ := cmd.Flags().GetString("state")
-
-			// First, get the issue to know which team it belongs to
-			issue, err := client.GetIssue(context.Background(), args[0])
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Get available states for the team
-			states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
+			stateID, err := resolveStateID(context.Background(), client, currentIssue().Team.Key, stateName)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.ID
-					break
-				}
-			}
-
-			if stateID == "" {
-				// Show available states
-				var stateNames []string
-				for _, state := range states {
-					stateNames = append(stateNames, state.Name)
-				}
-				output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
 			}
-
 			input["stateId"] = stateID
 		}
 
@@ -1137,35 +1189,87 @@ Examples:
 				parentIssue, err := client.GetIssue(context.Background(), parentValue)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to find parent issue '%s': %v", parentValue, err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 
 				// Prevent self-referencing
 				currentIssueID := args[0]
 				if parentIssue.Identifier == currentIssueID || parentIssue.ID == currentIssueID {
 					output.Error("An issue cannot be its own parent", plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitUsage)
 				}
 
 				input["parentId"] = parentIssue.ID
 			}
 		}
 
+		// Handle cycle update
+		if cmd.Flags().Changed("cycle") {
+			cycleValue, _ := cmd.Flags().GetString("cycle")
+			switch strings.ToLower(strings.TrimSpace(cycleValue)) {
+			case "none", "null", "":
+				input["cycleId"] = nil
+			default:
+				cycle, err := findCycle(context.Background(), client, currentIssue().Team.ID, cycleValue)
+				if err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				input["cycleId"] = cycle.ID
+			}
+		}
+
+		// Handle label updates
+		setLabels, _ := cmd.Flags().GetStringSlice("label")
+		addLabels, _ := cmd.Flags().GetStringSlice("add-label")
+		removeLabels, _ := cmd.Flags().GetStringSlice("remove-label")
+		if cmd.Flags().Changed("label") && (len(addLabels) > 0 || len(removeLabels) > 0) {
+			output.Error("--label replaces all labels and cannot be combined with --add-label or --remove-label", plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		resolveLabels := func(names []string) []string {
+			labelIDs, err := resolveLabelIDs(context.Background(), client, currentIssue().Team.ID, names)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if labelIDs == nil {
+				labelIDs = []string{}
+			}
+			return labelIDs
+		}
+		if cmd.Flags().Changed("label") {
+			input["labelIds"] = resolveLabels(setLabels)
+		}
+		if len(addLabels) > 0 {
+			input["addedLabelIds"] = resolveLabels(addLabels)
+		}
+		if len(removeLabels) > 0 {
+			input["removedLabelIds"] = resolveLabels(removeLabels)
+		}
+
 		// Handle project assignment update
 		if cmd.Flags().Changed("project") {
 			projectID, _ := cmd.Flags().GetString("project")
 			if val, ok, err := buildProjectInput(projectID); err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			} else if ok {
 				input["projectId"] = val
 			}
 		}
 
+		// Handle description update last, so a bad flag does not discard editor
+		// text or orphan uploads; the editor starts from the current description
+		currentDescription := func() string { return currentIssue().Description }
+		if description, ok := composeFlagText(cmd, "description", "description-file", currentDescription, client, plaintext, jsonOut); ok {
+			input["description"] = description
+		}
+
 		// Check if any updates were specified
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		// Update the issue
@@ -1176,11 +1280,11 @@ Examples:
 				projectID, _ := cmd.Flags().GetString("project")
 				if projectID != "" && projectID != "unassigned" && isProjectNotFoundErr(err) {
 					output.Error(fmt.Sprintf("Project '%s' not found", projectID), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitNotFound)
 				}
 			}
 			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1216,39 +1320,57 @@ func init() {
 	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
 	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
 	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueListCmd.Flags().StringSlice("label", nil, "Filter by label name (repeat to require several labels)")
+	issueListCmd.Flags().String("cycle", "", "Filter by cycle: current, next, previous, a cycle number, or none")
 	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	issueListCmd.Flags().Bool("archived", false, "Show only archived issues")
 	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	addPaginationFlags(issueListCmd)
+	addColumnFlags(issueListCmd, issueColumns)
 
 	// Issue search flags
 	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueSearchCmd.Flags().StringP("state", "s", "", "Filter by state name")
 	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key")
 	issueSearchCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueSearchCmd.Flags().StringSlice("label", nil, "Filter by label name (repeat to require several labels)")
+	issueSearchCmd.Flags().String("cycle", "", "Filter by cycle: current, next, previous, a cycle number, or none")
 	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueSearchCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
 	issueSearchCmd.Flags().Bool("include-archived", false, "Include archived issues in results")
 	issueSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueSearchCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	addPaginationFlags(issueSearchCmd)
+	addColumnFlags(issueSearchCmd, issueColumns)
 
 	// Issue create flags
 	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().String("description-file", "", "Read the description from a markdown file ('-' for stdin)")
+	issueCreateCmd.Flags().Bool("editor", false, "Write the description in $EDITOR")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required unless --parent is given)")
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
 	issueCreateCmd.Flags().String("project", "", "Project ID to assign issue to")
+	issueCreateCmd.Flags().StringSlice("label", nil, "Label names or IDs to apply (comma-separated or repeated)")
+	issueCreateCmd.Flags().String("parent", "", "Parent issue ID or identifier; the team defaults to the parent's")
 	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
 
 	// Issue update flags
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
+	issueUpdateCmd.Flags().String("description-file", "", "Read the new description from a markdown file ('-' for stdin)")
+	issueUpdateCmd.Flags().Bool("editor", false, "Edit the current description in $EDITOR")
 	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
 	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
 	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
 	issueUpdateCmd.Flags().String("parent", "", "Parent issue ID or identifier (use 'none' to remove parent)")
 	issueUpdateCmd.Flags().String("project", "", "Project ID to assign issue to (or 'unassigned' to remove)")
+	issueUpdateCmd.Flags().String("cycle", "", "Cycle: current, next, previous, a cycle number, or 'none' to remove")
+	issueUpdateCmd.Flags().StringSlice("label", nil, "Replace all labels with these names or IDs (empty to clear)")
+	issueUpdateCmd.Flags().StringSlice("add-label", nil, "Label names or IDs to add")
+	issueUpdateCmd.Flags().StringSlice("remove-label", nil, "Label names or IDs to remove")
 }
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueArchiveAPI defines the interface for archiving and deleting issues
+type issueArchiveAPI interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	ArchiveIssue(ctx context.Context, id string) error
+	UnarchiveIssue(ctx context.Context, id string) error
+	DeleteIssue(ctx context.Context, id string, permanent bool) error
+}
+
+// Injection points for testing
+var newIssueArchiveAPIClient = func(authHeader string) issueArchiveAPI { return api.NewClient(authHeader) }
+var getIssueArchiveAuthHeader = auth.GetAuthHeader
+
+// confirmAction asks a yes/no question on the terminal, defaulting to no
+var confirmAction = func(prompt string) bool {
+	fmt.Printf("%s [y/N]: ", prompt)
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
+
+// issueLifecycleAction is one of the archive, unarchive and delete operations
+type issueLifecycleAction struct {
+	verb  string // used in the confirmation prompt and errors
+	done  string // past tense, used in the results
+	icon  string
+	color color.Attribute
+	apply func(ctx context.Context, client issueArchiveAPI, id string) error
+}
+
+// issueLifecycleResult is the outcome for one issue
+type issueLifecycleResult struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+}
+
+var issueArchiveCmd = &cobra.Command{
+	Use:   "archive ISSUE...",
+	Short: "Archive issues",
+	Long: `Archive one or more issues. Archived issues can be found with
+'linctl issue list --archived' and restored with 'linctl issue unarchive'.
+
+Examples:
+  linctl issue archive ENG-1
+  linctl issue archive ENG-1 ENG-2 ENG-3 --force`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		action := issueLifecycleAction{
+			verb: "archive", done: "Archived", icon: "📦", color: color.FgYellow,
+			apply: func(ctx context.Context, client issueArchiveAPI, id string) error {
+				return client.ArchiveIssue(ctx, id)
+			},
+		}
+		runIssueLifecycle(cmd, issueArchiveClient(plaintext, jsonOut), action, args, plaintext, jsonOut)
+	},
+}
+
+var issueUnarchiveCmd = &cobra.Command{
+	Use:     "unarchive ISSUE...",
+	Aliases: []string{"restore"},
+	Short:   "Restore archived or deleted issues",
+	Long: `Restore one or more archived issues, or deleted issues that are still in the trash.
+
+Examples:
+  linctl issue unarchive ENG-1
+  linctl issue restore ENG-1 ENG-2`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		action := issueLifecycleAction{
+			verb: "restore", done: "Restored", icon: "↩", color: color.FgGreen,
+			apply: func(ctx context.Context, client issueArchiveAPI, id string) error {
+				return client.UnarchiveIssue(ctx, id)
+			},
+		}
+		runIssueLifecycle(cmd, issueArchiveClient(plaintext, jsonOut), action, args, plaintext, jsonOut)
+	},
+}
+
+var issueDeleteCmd = &cobra.Command{
+	Use:     "delete ISSUE...",
+	Aliases: []string{"rm", "remove"},
+	Short:   "Delete issues",
+	Long: `Delete one or more issues.
+
+By default, deleted issues go to the trash and can be restored with
+'linctl issue restore' for a limited time.
+Use --permanent to skip the trash (cannot be undone; admins only).
+
+Examples:
+  linctl issue delete ENG-1                # Move to trash
+  linctl issue delete ENG-1 ENG-2 --force  # Skip confirmation prompt
+  linctl issue delete ENG-1 --permanent    # Permanent delete (use with caution)`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		permanent, _ := cmd.Flags().GetBool("permanent")
+		action := issueLifecycleAction{
+			verb: "delete", done: "Deleted", icon: "🗑", color: color.FgRed,
+			apply: func(ctx context.Context, client issueArchiveAPI, id string) error {
+				return client.DeleteIssue(ctx, id, permanent)
+			},
+		}
+		if permanent {
+			action.verb = "PERMANENTLY DELETE"
+			action.done = "Permanently deleted"
+			action.icon = "✗"
+		}
+		runIssueLifecycle(cmd, issueArchiveClient(plaintext, jsonOut), action, args, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueArchiveCmd)
+	issueCmd.AddCommand(issueUnarchiveCmd)
+	issueCmd.AddCommand(issueDeleteCmd)
+
+	for _, cmd := range []*cobra.Command{issueArchiveCmd, issueUnarchiveCmd, issueDeleteCmd} {
+		cmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+	}
+	issueDeleteCmd.Flags().Bool("permanent", false, "Permanently delete instead of moving to the trash")
+}
+
+// issueArchiveClient authenticates and returns an archive API client, exiting on failure
+func issueArchiveClient(plaintext, jsonOut bool) issueArchiveAPI {
+	authHeader, err := getIssueArchiveAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newIssueArchiveAPIClient(authHeader)
+}
+
+// runIssueLifecycle looks up every issue first, confirms once, then applies
+// action to each issue, carrying on past failures and exiting non-zero if
+// any issue failed
+func runIssueLifecycle(cmd *cobra.Command, client issueArchiveAPI, action issueLifecycleAction, refs []string, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	force, _ := cmd.Flags().GetBool("force")
+
+	var issues []*api.Issue
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		issue, err := client.GetIssue(ctx, ref)
+		if err == nil && issue.ID == "" {
+			err = fmt.Errorf("issue %q %w", ref, api.ErrNotFound)
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find issue '%s': %v", ref, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if !seen[issue.ID] {
+			seen[issue.ID] = true
+			issues = append(issues, issue)
+		}
+	}
+
+	// Confirmation prompt (unless --force, --json or --dry-run)
+	if !force && !jsonOut && !dryRunEnabled() {
+		var prompt string
+		if len(issues) == 1 {
+			prompt = fmt.Sprintf("Are you sure you want to %s issue %s '%s'?", action.verb, issues[0].Identifier, issues[0].Title)
+		} else {
+			ids := make([]string, len(issues))
+			for i, issue := range issues {
+				ids[i] = issue.Identifier
+			}
+			prompt = fmt.Sprintf("Are you sure you want to %s %d issues (%s)?", action.verb, len(issues), strings.Join(ids, ", "))
+		}
+		if !confirmAction(prompt) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	if dryRunEnabled() {
+		startDryRunBatch()
+		for _, issue := range issues {
+			_ = action.apply(ctx, client, issue.ID)
+		}
+		finishDryRun(plaintext, jsonOut)
+		return
+	}
+
+	var firstErr error
+	results := make([]issueLifecycleResult, 0, len(issues))
+	for _, issue := range issues {
+		result := issueLifecycleResult{ID: issue.ID, Identifier: issue.Identifier, Title: issue.Title, Success: true}
+		if err := action.apply(ctx, client, issue.ID); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			result.Success = false
+			result.Error = err.Error()
+			if !jsonOut {
+				output.Error(fmt.Sprintf("Failed to %s %s: %v", strings.ToLower(action.verb), issue.Identifier, err), plaintext, jsonOut)
+			}
+		} else if plaintext {
+			fmt.Printf("%s issue: %s %s\n", action.done, issue.Identifier, issue.Title)
+		} else if !jsonOut {
+			fmt.Printf("%s %s issue %s %s\n",
+				color.New(action.color).Sprint(action.icon),
+				action.done,
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				issue.Title)
+		}
+		results = append(results, result)
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"success": firstErr == nil,
+			"action":  strings.ToLower(action.done),
+			"issues":  results,
+		})
+	}
+	if firstErr != nil {
+		os.Exit(exitCode(firstErr))
+	}
+}
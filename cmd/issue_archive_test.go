@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockIssueArchiveClient struct {
+	archived  []string
+	restored  []string
+	deleted   []string
+	permanent bool
+}
+
+func (m *mockIssueArchiveClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	return &api.Issue{ID: "id-" + id, Identifier: id, Title: "Issue " + id}, nil
+}
+
+func (m *mockIssueArchiveClient) ArchiveIssue(ctx context.Context, id string) error {
+	m.archived = append(m.archived, id)
+	return nil
+}
+
+func (m *mockIssueArchiveClient) UnarchiveIssue(ctx context.Context, id string) error {
+	m.restored = append(m.restored, id)
+	return nil
+}
+
+func (m *mockIssueArchiveClient) DeleteIssue(ctx context.Context, id string, permanent bool) error {
+	m.deleted = append(m.deleted, id)
+	m.permanent = permanent
+	return nil
+}
+
+func withConfirmAnswer(t *testing.T, answer bool, prompts *[]string) {
+	t.Helper()
+	old := confirmAction
+	confirmAction = func(prompt string) bool {
+		*prompts = append(*prompts, prompt)
+		return answer
+	}
+	t.Cleanup(func() { confirmAction = old })
+}
+
+func newLifecycleCommand(force bool) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("force", false, "")
+	if force {
+		_ = cmd.Flags().Set("force", "true")
+	}
+	return cmd
+}
+
+func archiveAction() issueLifecycleAction {
+	return issueLifecycleAction{
+		verb: "archive", done: "Archived",
+		apply: func(ctx context.Context, client issueArchiveAPI, id string) error {
+			return client.ArchiveIssue(ctx, id)
+		},
+	}
+}
+
+func TestIssueArchiveConfirmsOnceForAllIssues(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, true, &prompts)
+	mc := &mockIssueArchiveClient{}
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueLifecycle(newLifecycleCommand(false), mc, archiveAction(), []string{"ENG-1", "ENG-2", "ENG-1"}, true, false)
+	})
+
+	if !reflect.DeepEqual(prompts, []string{"Are you sure you want to archive 2 issues (ENG-1, ENG-2)?"}) {
+		t.Fatalf("prompts = %q", prompts)
+	}
+	if !reflect.DeepEqual(mc.archived, []string{"id-ENG-1", "id-ENG-2"}) {
+		t.Fatalf("archived = %v", mc.archived)
+	}
+	if !contains(out, "Archived issue: ENG-2 Issue ENG-2") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestIssueArchiveCancelled(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, false, &prompts)
+	mc := &mockIssueArchiveClient{}
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueLifecycle(newLifecycleCommand(false), mc, archiveAction(), []string{"ENG-1"}, true, false)
+	})
+	if len(mc.archived) != 0 || !contains(out, "Cancelled.") {
+		t.Fatalf("archived %v after declining; output %q", mc.archived, out)
+	}
+}
+
+func TestIssueLifecycleForceAndJSON(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, true, &prompts)
+
+	mc := &mockIssueArchiveClient{}
+	action := issueLifecycleAction{
+		verb: "delete", done: "Deleted",
+		apply: func(ctx context.Context, client issueArchiveAPI, id string) error {
+			return client.DeleteIssue(ctx, id, true)
+		},
+	}
+
+	run := func(refs []string, force, jsonOut bool) string {
+		return captureMilestoneStdout(t, func() {
+			runIssueLifecycle(newLifecycleCommand(force), mc, action, refs, false, jsonOut)
+		})
+	}
+
+	run([]string{"ENG-1"}, true, false)
+	out := run([]string{"ENG-3"}, false, true)
+
+	if len(prompts) != 0 {
+		t.Fatalf("--force and --json should not prompt, got %q", prompts)
+	}
+	if !reflect.DeepEqual(mc.deleted, []string{"id-ENG-1", "id-ENG-3"}) || !mc.permanent {
+		t.Fatalf("deleted = %v permanent = %v", mc.deleted, mc.permanent)
+	}
+	if !contains(out, `"action": "deleted"`) || !contains(out, `"identifier": "ENG-3"`) {
+		t.Fatalf("unexpected JSON: %s", out)
+	}
+}
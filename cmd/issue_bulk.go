@@ -0,0 +1,453 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueBulkAPI defines the interface for bulk issue updates
+type issueBulkAPI interface {
+	cycleAPI
+	labelLister
+	GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error)
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error)
+	GetViewer(ctx context.Context) (*api.User, error)
+	GetUsers(ctx context.Context, first int, after string, orderBy string) (*api.Users, error)
+	GetTeamStates(ctx context.Context, teamKey string) ([]api.WorkflowState, error)
+}
+
+// Injection points for testing
+var newIssueBulkAPIClient = func(authHeader string) issueBulkAPI { return api.NewClient(authHeader) }
+var getIssueBulkAuthHeader = auth.GetAuthHeader
+var bulkStdin io.Reader = os.Stdin
+
+// issueRefPattern matches a team-scoped issue identifier such as ENG-123
+var issueRefPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// bulkResult is the outcome of updating one issue
+type bulkResult struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+}
+
+var issueBulkUpdateCmd = &cobra.Command{
+	Use:   "bulk-update [ISSUE...]",
+	Short: "Update many issues at once",
+	Long: `Apply the same changes to many issues.
+
+Issues come from the arguments, from stdin when the only argument is '-', or
+otherwise from the same filter flags as 'issue list'. Stdin takes the first
+field of each line, so table output can be piped in; lines that don't start
+with an issue identifier (such as headers) are skipped.
+
+Names are resolved once per team before any issue is changed, and updates
+run in parallel. Updating issues matched by filters asks for confirmation.
+
+Examples:
+  linctl issue bulk-update ENG-1 ENG-2 --set-state Done
+  linctl issue list --team ENG --label regression --plaintext --columns identifier | \
+    linctl issue bulk-update - --set-cycle next --add-label triaged
+  linctl issue bulk-update --team ENG --state Todo --cycle previous --set-cycle current --force`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getIssueBulkAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		runIssueBulkUpdate(cmd, newIssueBulkAPIClient(authHeader), args, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueBulkUpdateCmd)
+
+	// Filters, as on issue list
+	issueBulkUpdateCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
+	issueBulkUpdateCmd.Flags().StringP("state", "s", "", "Filter by state name")
+	issueBulkUpdateCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueBulkUpdateCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueBulkUpdateCmd.Flags().StringSlice("label", nil, "Filter by label name (repeat to require several labels)")
+	issueBulkUpdateCmd.Flags().String("cycle", "", "Filter by cycle: current, next, previous, a cycle number, or none")
+	issueBulkUpdateCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	issueBulkUpdateCmd.Flags().StringP("newer-than", "n", "", "Only issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	issueBulkUpdateCmd.Flags().IntP("limit", "l", 250, "Maximum number of matching issues to update")
+	addPaginationFlags(issueBulkUpdateCmd)
+
+	// Changes
+	issueBulkUpdateCmd.Flags().String("set-state", "", "Move issues to this state")
+	issueBulkUpdateCmd.Flags().String("set-assignee", "", "Assignee (email, name, 'me', or 'unassigned')")
+	issueBulkUpdateCmd.Flags().Int("set-priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueBulkUpdateCmd.Flags().StringSlice("add-label", nil, "Label names or IDs to add")
+	issueBulkUpdateCmd.Flags().StringSlice("remove-label", nil, "Label names or IDs to remove")
+	issueBulkUpdateCmd.Flags().String("set-project", "", "Project ID to move issues to (or 'unassigned' to remove)")
+	issueBulkUpdateCmd.Flags().String("set-cycle", "", "Cycle: current, next, previous, a number, or 'none' to remove")
+
+	issueBulkUpdateCmd.Flags().Int("concurrency", 4, "Number of updates to run at the same time")
+	issueBulkUpdateCmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+}
+
+func runIssueBulkUpdate(cmd *cobra.Command, client issueBulkAPI, args []string, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	flags := cmd.Flags()
+
+	changed := false
+	for _, name := range []string{"set-state", "set-assignee", "set-priority", "add-label", "remove-label", "set-project", "set-cycle"} {
+		changed = changed || flags.Changed(name)
+	}
+	if !changed {
+		output.Error("No updates specified. Use --set-state, --set-assignee, --set-priority, --add-label, --remove-label, --set-project or --set-cycle.", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	concurrency, _ := flags.GetInt("concurrency")
+	if concurrency < 1 {
+		output.Error("--concurrency must be at least 1", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	// Changes that don't depend on the issue's team
+	input := map[string]interface{}{}
+	if flags.Changed("set-assignee") {
+		assignee, _ := flags.GetString("set-assignee")
+		assigneeID, err := resolveAssigneeID(ctx, client, assignee)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		input["assigneeId"] = assigneeID
+	}
+	if flags.Changed("set-priority") {
+		priority, _ := flags.GetInt("set-priority")
+		if priority < 0 || priority > 4 {
+			output.Error("--set-priority must be between 0 and 4", plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		input["priority"] = priority
+	}
+	if flags.Changed("set-project") {
+		project, _ := flags.GetString("set-project")
+		if val, ok, err := buildProjectInput(project); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		} else if ok {
+			input["projectId"] = val
+		}
+	}
+
+	issues, fromFilter := bulkTargets(ctx, cmd, client, args, plaintext, jsonOut)
+	if len(issues) == 0 {
+		output.Info("No issues to update", plaintext, jsonOut)
+		return
+	}
+
+	teamInputs := resolveBulkTeamChanges(ctx, cmd, client, issues, plaintext, jsonOut)
+
+	// Confirmation prompt for filter matches (unless --force, --json or --dry-run)
+	if force, _ := flags.GetBool("force"); fromFilter && !force && !jsonOut && !dryRunEnabled() {
+		if !confirmAction(fmt.Sprintf("Update %d issues matching the filters?", len(issues))) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	startDryRunBatch()
+	results := make([]bulkResult, len(issues))
+	errs := make([]error, len(issues))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, issue := range issues {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			issueInput := make(map[string]interface{}, len(input))
+			for key, value := range input {
+				issueInput[key] = value
+			}
+			if issue.Team != nil {
+				for key, value := range teamInputs[issue.Team.ID] {
+					issueInput[key] = value
+				}
+			}
+
+			result := bulkResult{ID: issue.ID, Identifier: issue.Identifier, Title: issue.Title, Success: true}
+			if _, err := client.UpdateIssue(ctx, issue.ID, issueInput); err != nil {
+				errs[i] = err
+				result.Success = false
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	if finishDryRun(plaintext, jsonOut) {
+		return
+	}
+	printBulkResults(results, plaintext, jsonOut)
+	for _, err := range errs {
+		if err != nil {
+			os.Exit(exitCode(err))
+		}
+	}
+}
+
+// bulkTargets returns the issues to update and whether they came from filters
+func bulkTargets(ctx context.Context, cmd *cobra.Command, client issueBulkAPI, args []string, plaintext, jsonOut bool) ([]api.Issue, bool) {
+	if len(args) == 0 {
+		filtered := false
+		for _, name := range []string{"assignee", "state", "team", "priority", "label", "cycle", "include-completed", "newer-than"} {
+			filtered = filtered || cmd.Flags().Changed(name)
+		}
+		if !filtered {
+			output.Error("Pass issue identifiers, '-' to read them from stdin, or at least one filter flag", plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		filter := buildIssueFilter(cmd)
+		pageSize, limit := paginationOptions(cmd)
+		issues, _, err := api.Collect(ctx, pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Issue, api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, filter, first, after, "", false)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		return issues, true
+	}
+
+	refs := args
+	if len(args) == 1 && args[0] == "-" {
+		var err error
+		if refs, err = readIssueRefs(bulkStdin); err != nil {
+			output.Error(fmt.Sprintf("Failed to read issues from stdin: %v", err), plaintext, jsonOut)
+			os.Exit(exitError)
+		}
+	}
+
+	var issues []api.Issue
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		issue, err := client.GetIssue(ctx, ref)
+		if err == nil && issue.ID == "" {
+			err = fmt.Errorf("issue %q %w", ref, api.ErrNotFound)
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find issue '%s': %v", ref, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if !seen[issue.ID] {
+			seen[issue.ID] = true
+			issues = append(issues, *issue)
+		}
+	}
+	return issues, false
+}
+
+// readIssueRefs reads the first field of each line that looks like an
+// issue identifier or ID
+func readIssueRefs(r io.Reader) ([]string, error) {
+	var refs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.FieldsFunc(scanner.Text(), func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+		if len(fields) == 0 {
+			continue
+		}
+		if ref := fields[0]; issueRefPattern.MatchString(ref) || isValidUUID(ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs, scanner.Err()
+}
+
+// resolveBulkTeamChanges resolves state, label and cycle names once for each
+// team among issues, exiting before any update if a name doesn't resolve
+func resolveBulkTeamChanges(ctx context.Context, cmd *cobra.Command, client issueBulkAPI, issues []api.Issue, plaintext, jsonOut bool) map[string]map[string]interface{} {
+	flags := cmd.Flags()
+	stateName, _ := flags.GetString("set-state")
+	addLabels, _ := flags.GetStringSlice("add-label")
+	removeLabels, _ := flags.GetStringSlice("remove-label")
+	cycleRef, _ := flags.GetString("set-cycle")
+
+	teamInputs := map[string]map[string]interface{}{}
+	for _, issue := range issues {
+		if issue.Team == nil || teamInputs[issue.Team.ID] != nil {
+			continue
+		}
+		team := issue.Team
+		teamInput := map[string]interface{}{}
+		fail := func(err error) {
+			output.Error(fmt.Sprintf("%s: %v", team.Key, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if flags.Changed("set-state") {
+			stateID, err := resolveStateID(ctx, client, team.Key, stateName)
+			if err != nil {
+				fail(err)
+			}
+			teamInput["stateId"] = stateID
+		}
+		if len(addLabels) > 0 {
+			ids, err := resolveLabelIDs(ctx, client, team.ID, addLabels)
+			if err != nil {
+				fail(err)
+			}
+			teamInput["addedLabelIds"] = ids
+		}
+		if len(removeLabels) > 0 {
+			ids, err := resolveLabelIDs(ctx, client, team.ID, removeLabels)
+			if err != nil {
+				fail(err)
+			}
+			teamInput["removedLabelIds"] = ids
+		}
+		if flags.Changed("set-cycle") {
+			switch strings.ToLower(strings.TrimSpace(cycleRef)) {
+			case "none", "null", "":
+				teamInput["cycleId"] = nil
+			default:
+				cycle, err := findCycle(ctx, client, team.ID, cycleRef)
+				if err != nil {
+					fail(err)
+				}
+				teamInput["cycleId"] = cycle.ID
+			}
+		}
+		teamInputs[team.ID] = teamInput
+	}
+	return teamInputs
+}
+
+func printBulkResults(results []bulkResult, plaintext, jsonOut bool) {
+	failed := 0
+	for _, result := range results {
+		if !result.Success {
+			failed++
+		}
+	}
+	updated := len(results) - failed
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"updated": updated,
+			"failed":  failed,
+			"results": results,
+		})
+		return
+	}
+
+	if plaintext {
+		for _, result := range results {
+			if result.Success {
+				fmt.Printf("Updated %s\n", result.Identifier)
+			} else {
+				fmt.Printf("Failed %s: %s\n", result.Identifier, result.Error)
+			}
+		}
+		fmt.Printf("\nTotal: %d updated, %d failed\n", updated, failed)
+		return
+	}
+
+	for _, result := range results {
+		if result.Success {
+			fmt.Printf("%s %s %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan).Sprint(result.Identifier),
+				result.Title)
+		} else {
+			fmt.Printf("%s %s %s\n",
+				color.New(color.FgRed).Sprint("✗"),
+				color.New(color.FgCyan).Sprint(result.Identifier),
+				color.New(color.FgRed).Sprint(result.Error))
+		}
+	}
+	summary := color.New(color.FgGreen).Sprintf("%d updated", updated)
+	if failed > 0 {
+		summary += ", " + color.New(color.FgRed).Sprintf("%d failed", failed)
+	}
+	fmt.Printf("\n%s\n", summary)
+}
+
+// assigneeResolver is the part of the API needed to resolve an assignee
+type assigneeResolver interface {
+	GetViewer(ctx context.Context) (*api.User, error)
+	GetUsers(ctx context.Context, first int, after string, orderBy string) (*api.Users, error)
+}
+
+// resolveAssigneeID maps 'me', 'unassigned', an email or a name to the value
+// of an assigneeId input field; nil unassigns
+func resolveAssigneeID(ctx context.Context, client assigneeResolver, assignee string) (interface{}, error) {
+	switch assignee {
+	case "me":
+		viewer, err := client.GetViewer(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		return viewer.ID, nil
+	case "unassigned", "":
+		return nil, nil
+	}
+
+	users, err := client.GetUsers(ctx, 100, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	for _, user := range users.Nodes {
+		if user.Email == assignee || user.Name == assignee {
+			return user.ID, nil
+		}
+	}
+	return nil, fmt.Errorf("user %q %w", assignee, api.ErrNotFound)
+}
+
+// stateResolver is the part of the API needed to resolve a workflow state
+type stateResolver interface {
+	GetTeamStates(ctx context.Context, teamKey string) ([]api.WorkflowState, error)
+}
+
+// resolveStateID finds a team's workflow state by name, ignoring case
+func resolveStateID(ctx context.Context, client stateResolver, teamKey, name string) (string, error) {
+	states, err := client.GetTeamStates(ctx, teamKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get team states: %w", err)
+	}
+
+	names := make([]string, len(states))
+	for i, state := range states {
+		if strings.EqualFold(state.Name, name) {
+			return state.ID, nil
+		}
+		names[i] = state.Name
+	}
+	return "", fmt.Errorf("state %q %w. Available states: %s", name, api.ErrNotFound, strings.Join(names, ", "))
+}
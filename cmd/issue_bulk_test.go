@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockIssueBulkClient struct {
+	mu          sync.Mutex
+	issues      []api.Issue
+	stateCalls  []string
+	issueFilter map[string]interface{}
+	updates     map[string]map[string]interface{}
+}
+
+func (m *mockIssueBulkClient) GetCycles(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Cycles, error) {
+	teamID := filter["team"].(map[string]interface{})["id"].(map[string]interface{})["eq"].(string)
+	return &api.Cycles{Nodes: []api.Cycle{{ID: "cycle-next-" + teamID}}}, nil
+}
+
+func (m *mockIssueBulkClient) GetCycle(ctx context.Context, id string) (*api.Cycle, error) {
+	return &api.Cycle{ID: id}, nil
+}
+
+func (m *mockIssueBulkClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
+	return &api.Team{ID: "team-" + strings.ToLower(key), Key: key}, nil
+}
+
+func (m *mockIssueBulkClient) GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.IssueLabels, error) {
+	return &api.IssueLabels{Nodes: []api.Label{{ID: "label-triaged", Name: "triaged"}}}, nil
+}
+
+func (m *mockIssueBulkClient) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error) {
+	m.issueFilter = filter
+	return &api.Issues{Nodes: m.issues}, nil
+}
+
+func (m *mockIssueBulkClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	for _, issue := range m.issues {
+		if strings.EqualFold(issue.Identifier, id) {
+			return &issue, nil
+		}
+	}
+	return &api.Issue{}, nil
+}
+
+func (m *mockIssueBulkClient) UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updates == nil {
+		m.updates = map[string]map[string]interface{}{}
+	}
+	m.updates[id] = input
+	return &api.Issue{ID: id}, nil
+}
+
+func (m *mockIssueBulkClient) GetViewer(ctx context.Context) (*api.User, error) {
+	return &api.User{ID: "user-me"}, nil
+}
+
+func (m *mockIssueBulkClient) GetUsers(ctx context.Context, first int, after string, orderBy string) (*api.Users, error) {
+	return &api.Users{Nodes: []api.User{{ID: "user-ada", Name: "Ada", Email: "ada@example.com"}}}, nil
+}
+
+func (m *mockIssueBulkClient) GetTeamStates(ctx context.Context, teamKey string) ([]api.WorkflowState, error) {
+	m.stateCalls = append(m.stateCalls, teamKey)
+	return []api.WorkflowState{{ID: "state-done-" + teamKey, Name: "Done"}}, nil
+}
+
+func sampleBulkIssues() []api.Issue {
+	eng := &api.Team{ID: "team-eng", Key: "ENG"}
+	ops := &api.Team{ID: "team-ops", Key: "OPS"}
+	return []api.Issue{
+		{ID: "id-1", Identifier: "ENG-1", Team: eng},
+		{ID: "id-2", Identifier: "ENG-2", Team: eng},
+		{ID: "id-3", Identifier: "OPS-1", Team: ops},
+	}
+}
+
+func newBulkCommand(t *testing.T, flags map[string]string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	fs := cmd.Flags()
+	fs.String("assignee", "", "")
+	fs.String("state", "", "")
+	fs.String("team", "", "")
+	fs.Int("priority", -1, "")
+	fs.StringSlice("label", nil, "")
+	fs.String("cycle", "", "")
+	fs.Bool("include-completed", false, "")
+	fs.String("newer-than", "", "")
+	fs.Int("limit", 250, "")
+	addPaginationFlags(cmd)
+	fs.String("set-state", "", "")
+	fs.String("set-assignee", "", "")
+	fs.Int("set-priority", -1, "")
+	fs.StringSlice("add-label", nil, "")
+	fs.StringSlice("remove-label", nil, "")
+	fs.String("set-project", "", "")
+	fs.String("set-cycle", "", "")
+	fs.Int("concurrency", 4, "")
+	fs.Bool("force", false, "")
+	for name, value := range flags {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+	return cmd
+}
+
+func TestReadIssueRefs(t *testing.T) {
+	input := "ID\tTitle\nENG-1\tFix login\n\n  ops-22, second\nnot an issue\n"
+	refs, err := readIssueRefs(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ENG-1", "ops-22"}; !reflect.DeepEqual(refs, want) {
+		t.Fatalf("refs = %v, want %v", refs, want)
+	}
+}
+
+func TestIssueBulkUpdateResolvesOncePerTeam(t *testing.T) {
+	mc := &mockIssueBulkClient{issues: sampleBulkIssues()}
+	cmd := newBulkCommand(t, map[string]string{
+		"set-state":    "done",
+		"set-assignee": "ada@example.com",
+		"add-label":    "triaged",
+		"set-cycle":    "next",
+		"concurrency":  "2",
+	})
+
+	oldStdin := bulkStdin
+	bulkStdin = strings.NewReader("ENG-1\nENG-2\nOPS-1\nENG-1\n")
+	defer func() { bulkStdin = oldStdin }()
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueBulkUpdate(cmd, mc, []string{"-"}, true, false)
+	})
+
+	sort.Strings(mc.stateCalls)
+	if want := []string{"ENG", "OPS"}; !reflect.DeepEqual(mc.stateCalls, want) {
+		t.Fatalf("states fetched for %v, want once per team", mc.stateCalls)
+	}
+	if len(mc.updates) != 3 {
+		t.Fatalf("updated %d issues, want 3", len(mc.updates))
+	}
+	want := map[string]interface{}{
+		"stateId":       "state-done-OPS",
+		"assigneeId":    "user-ada",
+		"addedLabelIds": []string{"label-triaged"},
+		"cycleId":       "cycle-next-team-ops",
+	}
+	if got := mc.updates["id-3"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("OPS-1 input = %v, want %v", got, want)
+	}
+	if !contains(out, "Updated ENG-2") || !contains(out, "Total: 3 updated, 0 failed") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestIssueBulkUpdateFromFilterConfirms(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, false, &prompts)
+
+	mc := &mockIssueBulkClient{issues: sampleBulkIssues()}
+	cmd := newBulkCommand(t, map[string]string{"team": "ENG", "set-priority": "1"})
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueBulkUpdate(cmd, mc, nil, true, false)
+	})
+
+	if mc.issueFilter["team"] == nil {
+		t.Fatalf("filter flags were not applied: %v", mc.issueFilter)
+	}
+	if len(prompts) != 1 || !contains(prompts[0], "Update 3 issues") {
+		t.Fatalf("prompts = %q", prompts)
+	}
+	if len(mc.updates) != 0 || !contains(out, "Cancelled.") {
+		t.Fatalf("updates sent after declining: %v", mc.updates)
+	}
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/dorkitude/linctl/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueHistoryAPI defines the interface for reading an issue's history
+type issueHistoryAPI interface {
+	labelLister
+	GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error)
+}
+
+// Injection points for testing
+var newIssueHistoryAPIClient = func(authHeader string) issueHistoryAPI { return api.NewClient(authHeader) }
+var getIssueHistoryAuthHeader = auth.GetAuthHeader
+
+// issueHistoryEvent is a history entry with its label IDs resolved to names
+// and a one-line summary of what changed
+type issueHistoryEvent struct {
+	api.IssueHistoryEntry
+	AddedLabels   []string `json:"addedLabels,omitempty"`
+	RemovedLabels []string `json:"removedLabels,omitempty"`
+	Summary       string   `json:"summary"`
+}
+
+var issueHistoryCmd = &cobra.Command{
+	Use:     "history ISSUE",
+	Aliases: []string{"activity"},
+	Short:   "Show an issue's change history",
+	Long: `Show every change made to an issue as a timeline, oldest first: state
+moves, assignment, priority, title, cycle, project and label changes.
+
+Examples:
+  linctl issue history ENG-123
+  linctl issue history ENG-123 --since 2_weeks_ago
+  linctl issue history ENG-123 --since 2024-06-01 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getIssueHistoryAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		runIssueHistory(cmd, newIssueHistoryAPIClient(authHeader), args[0], plaintext, jsonOut)
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueHistoryCmd)
+
+	issueHistoryCmd.Flags().StringP("since", "s", "", "Only show changes after this time (e.g. 3_days_ago, 2024-06-01)")
+}
+
+func runIssueHistory(cmd *cobra.Command, client issueHistoryAPI, ref string, plaintext, jsonOut bool) {
+	var since time.Time
+	if expr, _ := cmd.Flags().GetString("since"); expr != "" {
+		value, err := utils.ParseTimeExpression(expr)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid --since: %v", err), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		if value != "" {
+			since, _ = time.Parse(time.RFC3339, value)
+		}
+	}
+
+	ctx := context.Background()
+	entries, _, err := api.Collect(ctx, api.MaxPageSize, 0, issueHistoryPages(client, ref))
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch history: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	kept := entries[:0]
+	for _, entry := range entries {
+		if entry.CreatedAt.After(since) {
+			kept = append(kept, entry)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].CreatedAt.Before(kept[j].CreatedAt) })
+
+	labelNames, err := historyLabelNames(ctx, client, kept)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch labels: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	events := make([]issueHistoryEvent, len(kept))
+	for i, entry := range kept {
+		events[i] = newIssueHistoryEvent(entry, labelNames)
+	}
+
+	if jsonOut {
+		output.JSON(events)
+		return
+	}
+
+	if len(events) == 0 {
+		if plaintext {
+			fmt.Println("No history found")
+		} else {
+			fmt.Printf("%s No history found\n", color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("# History of %s\n\n", strings.ToUpper(ref))
+		for _, event := range events {
+			fmt.Printf("- %s %s %s\n", event.CreatedAt.Format("2006-01-02 15:04"), historyActor(event.Actor), event.Summary)
+		}
+		return
+	}
+
+	fmt.Printf("%s %s\n\n",
+		color.New(color.FgWhite, color.Bold).Sprint("History of"),
+		color.New(color.FgCyan, color.Bold).Sprint(strings.ToUpper(ref)))
+	for _, event := range events {
+		fmt.Printf("%s %s %s, %s\n",
+			color.New(color.FgBlue).Sprint("●"),
+			color.New(color.FgWhite, color.Bold).Sprint(historyActor(event.Actor)),
+			event.Summary,
+			color.New(color.FgWhite, color.Faint).Sprint(formatTimeAgo(event.CreatedAt)))
+	}
+}
+
+// issueHistoryPages adapts GetIssueHistory to api.PageFunc
+func issueHistoryPages(client issueHistoryAPI, issueID string) api.PageFunc[api.IssueHistoryEntry] {
+	return func(ctx context.Context, first int, after string) ([]api.IssueHistoryEntry, api.PageInfo, error) {
+		page, err := client.GetIssueHistory(ctx, issueID, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	}
+}
+
+// historyLabelNames maps label IDs to names, fetching labels only when some
+// entry added or removed one
+func historyLabelNames(ctx context.Context, client labelLister, entries []api.IssueHistoryEntry) (map[string]string, error) {
+	names := map[string]string{}
+	needed := false
+	for _, entry := range entries {
+		if len(entry.AddedLabelIds) > 0 || len(entry.RemovedLabelIds) > 0 {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return names, nil
+	}
+
+	labels, _, err := api.Collect(ctx, api.MaxPageSize, 0, labelPages(client, nil))
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		names[label.ID] = label.Name
+	}
+	return names, nil
+}
+
+// newIssueHistoryEvent resolves entry's labels and summarizes its changes.
+// Labels that no longer exist are shown by ID.
+func newIssueHistoryEvent(entry api.IssueHistoryEntry, labelNames map[string]string) issueHistoryEvent {
+	event := issueHistoryEvent{IssueHistoryEntry: entry}
+	for _, id := range entry.AddedLabelIds {
+		event.AddedLabels = append(event.AddedLabels, labelName(labelNames, id))
+	}
+	for _, id := range entry.RemovedLabelIds {
+		event.RemovedLabels = append(event.RemovedLabels, labelName(labelNames, id))
+	}
+
+	var changes []string
+	if to := entry.ToState; to != nil {
+		if from := entry.FromState; from != nil {
+			changes = append(changes, fmt.Sprintf("moved %s → %s", from.Name, to.Name))
+		} else {
+			changes = append(changes, fmt.Sprintf("set state to %s", to.Name))
+		}
+	}
+	switch from, to := entry.FromAssignee, entry.ToAssignee; {
+	case from != nil && to != nil:
+		changes = append(changes, fmt.Sprintf("reassigned %s → %s", from.Name, to.Name))
+	case to != nil:
+		changes = append(changes, fmt.Sprintf("assigned %s", to.Name))
+	case from != nil:
+		changes = append(changes, fmt.Sprintf("unassigned %s", from.Name))
+	}
+	if to := entry.ToPriority; to != nil {
+		if from := entry.FromPriority; from != nil {
+			changes = append(changes, fmt.Sprintf("changed priority %s → %s", priorityToString(*from), priorityToString(*to)))
+		} else {
+			changes = append(changes, fmt.Sprintf("set priority to %s", priorityToString(*to)))
+		}
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("renamed %q → %q", *entry.FromTitle, *entry.ToTitle))
+	}
+	switch from, to := entry.FromCycle, entry.ToCycle; {
+	case from != nil && to != nil:
+		changes = append(changes, fmt.Sprintf("moved from %s to %s", cycleTitle(*from), cycleTitle(*to)))
+	case to != nil:
+		changes = append(changes, fmt.Sprintf("added to %s", cycleTitle(*to)))
+	case from != nil:
+		changes = append(changes, fmt.Sprintf("removed from %s", cycleTitle(*from)))
+	}
+	switch from, to := entry.FromProject, entry.ToProject; {
+	case from != nil && to != nil:
+		changes = append(changes, fmt.Sprintf("moved from project %s to %s", from.Name, to.Name))
+	case to != nil:
+		changes = append(changes, fmt.Sprintf("added to project %s", to.Name))
+	case from != nil:
+		changes = append(changes, fmt.Sprintf("removed from project %s", from.Name))
+	}
+	if len(event.AddedLabels) > 0 {
+		changes = append(changes, "added "+labelList(event.AddedLabels))
+	}
+	if len(event.RemovedLabels) > 0 {
+		changes = append(changes, "removed "+labelList(event.RemovedLabels))
+	}
+
+	if len(changes) == 0 {
+		event.Summary = "updated the issue"
+	} else {
+		event.Summary = strings.Join(changes, "; ")
+	}
+	return event
+}
+
+// labelList phrases label names as "label bug" or "labels bug, ui"
+func labelList(names []string) string {
+	if len(names) == 1 {
+		return "label " + names[0]
+	}
+	return "labels " + strings.Join(names, ", ")
+}
+
+func labelName(names map[string]string, id string) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+	return id
+}
+
+// historyActor names who made a change; entries without an actor come from
+// automations and integrations
+func historyActor(actor *api.User) string {
+	if actor == nil || actor.Name == "" {
+		return "Someone"
+	}
+	return actor.Name
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockIssueHistoryClient struct {
+	pages       [][]api.IssueHistoryEntry
+	labelCalls  int
+	historyRefs []string
+}
+
+func (m *mockIssueHistoryClient) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error) {
+	m.historyRefs = append(m.historyRefs, issueID+"@"+after)
+	page := 0
+	if after != "" {
+		page = 1
+	}
+	history := &api.IssueHistory{Nodes: m.pages[page]}
+	if page+1 < len(m.pages) {
+		history.PageInfo = api.PageInfo{HasNextPage: true, EndCursor: "cursor-1"}
+	}
+	return history, nil
+}
+
+func (m *mockIssueHistoryClient) GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.IssueLabels, error) {
+	m.labelCalls++
+	return &api.IssueLabels{Nodes: []api.Label{{ID: "label-bug", Name: "bug"}, {ID: "label-ui", Name: "ui"}}}, nil
+}
+
+func sampleIssueHistory() *mockIssueHistoryClient {
+	ada := &api.User{Name: "Ada"}
+	todo, doing := &api.State{Name: "Todo"}, &api.State{Name: "In Progress"}
+	high, urgent := 2, 1
+	return &mockIssueHistoryClient{pages: [][]api.IssueHistoryEntry{
+		{
+			{ID: "h3", CreatedAt: time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC), Actor: ada,
+				FromState: todo, ToState: doing, ToAssignee: &api.User{Name: "Grace"}},
+			{ID: "h1", CreatedAt: time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC), Actor: ada,
+				AddedLabelIds: []string{"label-bug", "label-ui"}, RemovedLabelIds: []string{"label-gone"}},
+		},
+		{
+			{ID: "h2", CreatedAt: time.Date(2024, 6, 2, 9, 0, 0, 0, time.UTC),
+				FromPriority: &high, ToPriority: &urgent, ToCycle: &api.Cycle{Number: 12}},
+		},
+	}}
+}
+
+func newHistoryCommand(t *testing.T, since string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("since", "", "")
+	if since != "" {
+		_ = cmd.Flags().Set("since", since)
+	}
+	return cmd
+}
+
+func TestIssueHistoryTimeline(t *testing.T) {
+	mc := sampleIssueHistory()
+	out := captureMilestoneStdout(t, func() {
+		runIssueHistory(newHistoryCommand(t, ""), mc, "eng-1", true, false)
+	})
+
+	if len(mc.historyRefs) != 2 {
+		t.Fatalf("fetched %v, want both pages", mc.historyRefs)
+	}
+	want := "# History of ENG-1\n\n" +
+		"- 2024-06-01 09:00 Ada added labels bug, ui; removed label label-gone\n" +
+		"- 2024-06-02 09:00 Someone changed priority High → Urgent; added to Cycle 12\n" +
+		"- 2024-06-03 09:00 Ada moved Todo → In Progress; assigned Grace\n"
+	if out != want {
+		t.Fatalf("output =\n%s\nwant\n%s", out, want)
+	}
+}
+
+func TestIssueHistorySinceJSON(t *testing.T) {
+	mc := sampleIssueHistory()
+	out := captureMilestoneStdout(t, func() {
+		runIssueHistory(newHistoryCommand(t, "2024-06-02"), mc, "ENG-1", false, true)
+	})
+
+	var events []issueHistoryEvent
+	if err := json.Unmarshal([]byte(out), &events); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(events) != 2 || events[0].ID != "h2" || events[1].ID != "h3" {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+	if events[1].Summary != "moved Todo → In Progress; assigned Grace" {
+		t.Fatalf("summary = %q", events[1].Summary)
+	}
+	// No remaining entry touches labels, so none are fetched
+	if mc.labelCalls != 0 {
+		t.Fatalf("labels fetched %d times, want 0", mc.labelCalls)
+	}
+}
@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
 )
 
 func TestIsValidUUID(t *testing.T) {
@@ -53,13 +56,20 @@ func TestBuildProjectInput(t *testing.T) {
 }
 
 func TestIsProjectNotFoundErr(t *testing.T) {
+	notFound := &api.GraphQLErrorExtensions{Code: "NOT_FOUND"}
 	cases := []struct {
 		in   error
 		want bool
 	}{
-		{errors.New("GraphQL errors: [{ message: 'Project not found' }]"), true},
-		{errors.New("something about projectId not found"), true},
-		{errors.New("issue not found"), false},
+		{api.GraphQLErrors{{Message: "Entity not found: Project", Extensions: notFound}}, true},
+		{fmt.Errorf("update issue: %w", &api.HTTPError{StatusCode: 400, Errors: api.GraphQLErrors{{Message: "Entity not found: Project", Extensions: notFound}}}), true},
+		{api.GraphQLErrors{{Message: "Entity not found: Issue", Extensions: notFound}}, false},
+		// Not-found errors that do not name the project are not blamed on it
+		{fmt.Errorf("issue ENG-999 %w", api.ErrNotFound), false},
+		{&api.HTTPError{StatusCode: 404, Body: "Not Found"}, false},
+		{api.GraphQLErrors{{Message: "Entity not found: Project"}}, false},
+		// Only typed errors count, whatever the message says
+		{errors.New("Project not found"), false},
 		{errors.New("unknown error"), false},
 		{nil, false},
 	}
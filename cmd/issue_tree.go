@@ -0,0 +1,292 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// issueTreeAPI defines the interface for walking the sub-issue hierarchy
+type issueTreeAPI interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	GetIssueChildren(ctx context.Context, id string, first int, after string) (*api.Issues, error)
+}
+
+// Injection points for testing
+var newIssueTreeAPIClient = func(authHeader string) issueTreeAPI { return api.NewClient(authHeader) }
+var getIssueTreeAuthHeader = auth.GetAuthHeader
+
+// issueTreeNode is an issue with its whole sub-issue tree
+type issueTreeNode struct {
+	ID         string           `json:"id"`
+	Identifier string           `json:"identifier"`
+	Title      string           `json:"title"`
+	URL        string           `json:"url,omitempty"`
+	Estimate   *float64         `json:"estimate,omitempty"`
+	State      *api.State       `json:"state"`
+	Assignee   *api.User        `json:"assignee"`
+	Progress   issueTreeRollup  `json:"progress"`
+	Children   []*issueTreeNode `json:"children"`
+}
+
+// issueTreeRollup counts a node's descendants by outcome. Canceled issues
+// don't count towards Percent.
+type issueTreeRollup struct {
+	Total     int     `json:"total"`
+	Completed int     `json:"completed"`
+	Canceled  int     `json:"canceled"`
+	Percent   float64 `json:"percent"`
+}
+
+var issueTreeCmd = &cobra.Command{
+	Use:   "tree ISSUE",
+	Short: "Show an issue's full sub-issue tree",
+	Long: `Recursively fetch and print an issue's sub-issues with their states and
+the share of descendants that are done.
+
+Examples:
+  linctl issue tree ENG-100
+  linctl issue tree ENG-100 --depth 2
+  linctl issue tree ENG-100 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getIssueTreeAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		runIssueTree(cmd, newIssueTreeAPIClient(authHeader), args[0], plaintext, jsonOut)
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueTreeCmd)
+
+	issueTreeCmd.Flags().Int("depth", 0, "Maximum depth of sub-issues to fetch (0 for no limit)")
+}
+
+func runIssueTree(cmd *cobra.Command, client issueTreeAPI, ref string, plaintext, jsonOut bool) {
+	depth, _ := cmd.Flags().GetInt("depth")
+	if depth < 0 {
+		output.Error("--depth must be 0 or greater", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	issue, err := client.GetIssue(ctx, ref)
+	if err == nil && issue.ID == "" {
+		err = fmt.Errorf("issue %q %w", ref, api.ErrNotFound)
+	}
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	root, err := buildIssueTree(ctx, client, issue, depth)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch sub-issues: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(root)
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("# %s - %s %s\n\n", root.Identifier, root.Title, issueStateMarker(root.State))
+		printPlainIssueTree(root.Children, "")
+		if root.Progress.Total == 0 {
+			fmt.Println("No sub-issues")
+			return
+		}
+		fmt.Printf("\nProgress: %s\n", rollupSummary(root.Progress))
+		return
+	}
+
+	fmt.Printf("%s %s %s\n",
+		issueStateIcon(root.State),
+		color.New(color.FgCyan, color.Bold).Sprint(root.Identifier),
+		color.New(color.FgWhite, color.Bold).Sprint(root.Title))
+	printRichIssueTree(root.Children, "")
+	if root.Progress.Total == 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("No sub-issues"))
+		return
+	}
+	fmt.Printf("\n%s %s %s\n",
+		color.New(color.FgYellow).Sprint("Progress:"),
+		color.New(color.FgGreen).Sprint(progressBar(root.Progress.Percent/100, 20)),
+		rollupSummary(root.Progress))
+}
+
+// buildIssueTree fetches issue's descendants recursively, stopping after
+// maxDepth levels when maxDepth is positive
+func buildIssueTree(ctx context.Context, client issueTreeAPI, issue *api.Issue, maxDepth int) (*issueTreeNode, error) {
+	seen := map[string]bool{}
+	var walk func(issue *api.Issue, level int) (*issueTreeNode, error)
+	walk = func(issue *api.Issue, level int) (*issueTreeNode, error) {
+		node := &issueTreeNode{
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			URL:        issue.URL,
+			Estimate:   issue.Estimate,
+			State:      issue.State,
+			Assignee:   issue.Assignee,
+			Children:   []*issueTreeNode{},
+		}
+		seen[issue.ID] = true
+
+		// Children fetched through GetIssueChildren carry a single child
+		// as a hint; none means there is nothing below
+		leaf := level > 0 && (issue.Children == nil || len(issue.Children.Nodes) == 0)
+		if leaf || (maxDepth > 0 && level >= maxDepth) {
+			return node, nil
+		}
+
+		children, _, err := api.Collect(ctx, api.MaxPageSize, 0,
+			func(ctx context.Context, first int, after string) ([]api.Issue, api.PageInfo, error) {
+				page, err := client.GetIssueChildren(ctx, issue.ID, first, after)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+		for i := range children {
+			if seen[children[i].ID] {
+				continue
+			}
+			child, err := walk(&children[i], level+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+		return node, nil
+	}
+
+	root, err := walk(issue, 0)
+	if err != nil {
+		return nil, err
+	}
+	root.rollup()
+	return root, nil
+}
+
+// rollup fills in Progress for n and all of its descendants
+func (n *issueTreeNode) rollup() issueTreeRollup {
+	var r issueTreeRollup
+	for _, child := range n.Children {
+		sub := child.rollup()
+		r.Total += sub.Total + 1
+		r.Completed += sub.Completed
+		r.Canceled += sub.Canceled
+		if child.State != nil {
+			switch child.State.Type {
+			case "completed":
+				r.Completed++
+			case "canceled":
+				r.Canceled++
+			}
+		}
+	}
+	if active := r.Total - r.Canceled; active > 0 {
+		r.Percent = float64(r.Completed) / float64(active) * 100
+	}
+	n.Progress = r
+	return r
+}
+
+// rollupSummary formats a rollup as e.g. "3/5 sub-issues done (60%)"
+func rollupSummary(r issueTreeRollup) string {
+	summary := fmt.Sprintf("%d/%d sub-issues done (%.0f%%)", r.Completed, r.Total-r.Canceled, r.Percent)
+	if r.Canceled > 0 {
+		summary += fmt.Sprintf(", %d canceled", r.Canceled)
+	}
+	return summary
+}
+
+func printPlainIssueTree(nodes []*issueTreeNode, indent string) {
+	for _, node := range nodes {
+		fmt.Printf("%s- %s %s: %s", indent, issueStateMarker(node.State), node.Identifier, node.Title)
+		if node.Assignee != nil {
+			fmt.Printf(" (%s)", node.Assignee.Name)
+		}
+		if node.Progress.Total > 0 {
+			fmt.Printf(" [%d/%d]", node.Progress.Completed, node.Progress.Total-node.Progress.Canceled)
+		}
+		fmt.Println()
+		printPlainIssueTree(node.Children, indent+"  ")
+	}
+}
+
+func printRichIssueTree(nodes []*issueTreeNode, prefix string) {
+	faint := color.New(color.FgWhite, color.Faint)
+	for i, node := range nodes {
+		branch, next := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		line := fmt.Sprintf("%s%s %s %s",
+			faint.Sprint(prefix+branch),
+			issueStateIcon(node.State),
+			color.New(color.FgCyan).Sprint(node.Identifier),
+			node.Title)
+		if node.Assignee != nil {
+			line += faint.Sprintf(" (%s)", node.Assignee.Name)
+		}
+		if node.Progress.Total > 0 {
+			line += color.New(color.FgYellow).Sprintf(" %d/%d", node.Progress.Completed, node.Progress.Total-node.Progress.Canceled)
+		}
+		fmt.Println(line)
+		printRichIssueTree(node.Children, prefix+next)
+	}
+}
+
+// issueStateMarker is the markdown checkbox for a workflow state
+func issueStateMarker(state *api.State) string {
+	if state == nil {
+		return "[ ]"
+	}
+	switch state.Type {
+	case "completed", "done":
+		return "[x]"
+	case "started", "in_progress":
+		return "[~]"
+	case "canceled":
+		return "[-]"
+	default:
+		return "[ ]"
+	}
+}
+
+// issueStateIcon is the colored icon for a workflow state
+func issueStateIcon(state *api.State) string {
+	if state == nil {
+		return "○"
+	}
+	switch state.Type {
+	case "completed", "done":
+		return color.New(color.FgGreen).Sprint("✓")
+	case "started", "in_progress":
+		return color.New(color.FgBlue).Sprint("◐")
+	case "canceled":
+		return color.New(color.FgRed).Sprint("✗")
+	default:
+		return "○"
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockIssueTreeClient struct {
+	issues   map[string]*api.Issue
+	children map[string][]api.Issue
+	fetched  []string
+}
+
+func (m *mockIssueTreeClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	if issue, ok := m.issues[id]; ok {
+		return issue, nil
+	}
+	return &api.Issue{}, nil
+}
+
+func (m *mockIssueTreeClient) GetIssueChildren(ctx context.Context, id string, first int, after string) (*api.Issues, error) {
+	m.fetched = append(m.fetched, id)
+	return &api.Issues{Nodes: m.children[id]}, nil
+}
+
+func treeIssue(id, stateType string, hasChildren bool) api.Issue {
+	issue := api.Issue{ID: id, Identifier: id, Title: "Issue " + id, State: &api.State{Name: stateType, Type: stateType}}
+	issue.Children = &api.Issues{}
+	if hasChildren {
+		issue.Children.Nodes = []api.Issue{{ID: "hint"}}
+	}
+	return issue
+}
+
+func sampleIssueTree() *mockIssueTreeClient {
+	root := treeIssue("ENG-100", "started", true)
+	return &mockIssueTreeClient{
+		issues: map[string]*api.Issue{"ENG-100": &root},
+		children: map[string][]api.Issue{
+			"ENG-100": {treeIssue("ENG-101", "completed", false), treeIssue("ENG-102", "started", true)},
+			"ENG-102": {treeIssue("ENG-103", "completed", false), treeIssue("ENG-104", "canceled", false), treeIssue("ENG-105", "unstarted", false)},
+		},
+	}
+}
+
+func TestBuildIssueTreeRollup(t *testing.T) {
+	mc := sampleIssueTree()
+	root, err := buildIssueTree(context.Background(), mc, mc.issues["ENG-100"], 0)
+	if err != nil {
+		t.Fatalf("buildIssueTree: %v", err)
+	}
+
+	// Leaves are never asked for their children
+	if len(mc.fetched) != 2 {
+		t.Fatalf("fetched children of %v, want only ENG-100 and ENG-102", mc.fetched)
+	}
+
+	want := issueTreeRollup{Total: 5, Completed: 2, Canceled: 1, Percent: 50}
+	if root.Progress != want {
+		t.Fatalf("root progress = %+v, want %+v", root.Progress, want)
+	}
+	if got := root.Children[1].Progress; got.Total != 3 || got.Completed != 1 {
+		t.Fatalf("ENG-102 progress = %+v", got)
+	}
+}
+
+func TestBuildIssueTreeDepth(t *testing.T) {
+	mc := sampleIssueTree()
+	root, err := buildIssueTree(context.Background(), mc, mc.issues["ENG-100"], 1)
+	if err != nil {
+		t.Fatalf("buildIssueTree: %v", err)
+	}
+	if len(root.Children) != 2 || len(root.Children[1].Children) != 0 {
+		t.Fatalf("depth 1 should stop below direct children")
+	}
+}
+
+func TestIssueTreeOutput(t *testing.T) {
+	mc := sampleIssueTree()
+	cmd := &cobra.Command{}
+	cmd.Flags().Int("depth", 0, "")
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueTree(cmd, mc, "ENG-100", true, false)
+	})
+	for _, want := range []string{"- [x] ENG-101", "- [~] ENG-102: Issue ENG-102 [1/2]", "  - [-] ENG-104", "Progress: 2/4 sub-issues done (50%), 1 canceled"} {
+		if !contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	out = captureMilestoneStdout(t, func() {
+		runIssueTree(cmd, sampleIssueTree(), "ENG-100", false, true)
+	})
+	var node issueTreeNode
+	if err := json.Unmarshal([]byte(out), &node); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(node.Children) != 2 || len(node.Children[1].Children) != 3 {
+		t.Fatalf("unexpected JSON tree: %s", out)
+	}
+}
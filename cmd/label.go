@@ -0,0 +1,528 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// labelLister is the subset of the API needed to resolve label names
+type labelLister interface {
+	GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.IssueLabels, error)
+}
+
+// labelAPI defines the interface for label operations
+type labelAPI interface {
+	labelLister
+	CreateLabel(ctx context.Context, input map[string]interface{}) (*api.Label, error)
+	UpdateLabel(ctx context.Context, id string, input map[string]interface{}) (*api.Label, error)
+	DeleteLabel(ctx context.Context, id string) error
+	GetTeam(ctx context.Context, key string) (*api.Team, error)
+}
+
+// Injection points for testing
+var newLabelAPIClient = func(authHeader string) labelAPI { return api.NewClient(authHeader) }
+var getLabelAuthHeader = auth.GetAuthHeader
+
+var labelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Manage issue labels",
+	Long: `List, create, update, and delete issue labels.
+
+Labels belong either to a team or to the whole workspace. Labels can be
+grouped under a parent label group; refer to a grouped label as
+"Group/Name" when its name alone is ambiguous.
+
+Examples:
+  linctl label list --team ENG
+  linctl label create "needs-design" --team ENG --color "#f2994a"
+  linctl label create "Type" --group
+  linctl label create "Bug" --parent "Type"
+  linctl label update "needs-design" --team ENG --name "design-review"
+  linctl label delete "design-review" --team ENG --force`,
+}
+
+var labelListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List labels",
+	Long:    `List issue labels in the workspace, a team, or both.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		columns, _ := selectColumns(cmd, labelColumns, plaintext, jsonOut)
+
+		client := labelClient(plaintext, jsonOut)
+		runLabelList(cmd, client, columns, plaintext, jsonOut)
+	},
+}
+
+var labelCreateCmd = &cobra.Command{
+	Use:     "create NAME",
+	Aliases: []string{"new"},
+	Short:   "Create a label",
+	Long:    `Create an issue label in a team, or in the workspace when --team is omitted.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := labelClient(plaintext, jsonOut)
+		runLabelCreate(cmd, client, args[0], plaintext, jsonOut)
+	},
+}
+
+var labelUpdateCmd = &cobra.Command{
+	Use:   "update LABEL",
+	Short: "Update a label",
+	Long:  `Update an issue label. LABEL is a label ID or name; use --team to pick a team's label by name.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := labelClient(plaintext, jsonOut)
+		runLabelUpdate(cmd, client, args[0], plaintext, jsonOut)
+	},
+}
+
+var labelDeleteCmd = &cobra.Command{
+	Use:     "delete LABEL",
+	Aliases: []string{"rm"},
+	Short:   "Delete a label",
+	Long:    `Delete an issue label. LABEL is a label ID or name; use --team to pick a team's label by name.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := labelClient(plaintext, jsonOut)
+		runLabelDelete(cmd, client, args[0], plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(labelCmd)
+	labelCmd.AddCommand(labelListCmd)
+	labelCmd.AddCommand(labelCreateCmd)
+	labelCmd.AddCommand(labelUpdateCmd)
+	labelCmd.AddCommand(labelDeleteCmd)
+
+	// List flags
+	labelListCmd.Flags().StringP("team", "t", "", "Only show labels of this team")
+	labelListCmd.Flags().Bool("workspace", false, "Only show workspace labels")
+	labelListCmd.Flags().IntP("limit", "l", 250, "Maximum number of labels to return")
+	addPaginationFlags(labelListCmd)
+	addColumnFlags(labelListCmd, labelColumns)
+
+	// Create flags
+	labelCreateCmd.Flags().StringP("team", "t", "", "Team key (omit for a workspace label)")
+	labelCreateCmd.Flags().String("color", "", "Label color as a hex value, e.g. #4ea7fc")
+	labelCreateCmd.Flags().StringP("description", "d", "", "Label description")
+	labelCreateCmd.Flags().String("parent", "", "Label group to create the label in (name or ID)")
+	labelCreateCmd.Flags().Bool("group", false, "Create a label group instead of a label")
+
+	// Update flags
+	labelUpdateCmd.Flags().StringP("team", "t", "", "Team key used to find LABEL by name")
+	labelUpdateCmd.Flags().String("name", "", "New label name")
+	labelUpdateCmd.Flags().String("color", "", "New label color as a hex value")
+	labelUpdateCmd.Flags().StringP("description", "d", "", "New label description")
+	labelUpdateCmd.Flags().String("parent", "", "Label group to move the label to (name or ID, or 'none' to ungroup)")
+
+	// Delete flags
+	labelDeleteCmd.Flags().StringP("team", "t", "", "Team key used to find LABEL by name")
+	labelDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+}
+
+// labelClient authenticates and returns a label API client, exiting on failure
+func labelClient(plaintext, jsonOut bool) labelAPI {
+	authHeader, err := getLabelAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newLabelAPIClient(authHeader)
+}
+
+// labelColumns are the table columns available to label list
+var labelColumns = columnSet[api.Label]{
+	columns: []tableColumn[api.Label]{
+		{Name: "id", Header: "ID", Value: func(l api.Label) string { return l.ID }},
+		{Name: "name", Header: "Name", Value: func(l api.Label) string { return l.Name },
+			Color: func(l api.Label) *color.Color {
+				if l.IsGroup {
+					return color.New(color.Bold)
+				}
+				return nil
+			}},
+		{Name: "group", Header: "Group", Value: func(l api.Label) string {
+			if l.Parent == nil {
+				return ""
+			}
+			return l.Parent.Name
+		}},
+		{Name: "team", Header: "Team", Value: func(l api.Label) string {
+			if l.Team == nil {
+				return "Workspace"
+			}
+			return l.Team.Key
+		}, Color: func(l api.Label) *color.Color {
+			if l.Team == nil {
+				return color.New(color.FgWhite, color.Faint)
+			}
+			return color.New(color.FgCyan)
+		}},
+		{Name: "color", Header: "Color", Value: func(l api.Label) string { return l.Color }},
+		{Name: "description", Header: "Description", Flex: true, Value: func(l api.Label) string {
+			if l.Description == nil {
+				return ""
+			}
+			return *l.Description
+		}},
+	},
+	defaults: []string{"name", "group", "team", "color", "description"},
+	wide:     []string{"id", "name", "group", "team", "color", "description"},
+}
+
+func runLabelList(cmd *cobra.Command, client labelAPI, columns []tableColumn[api.Label], plaintext, jsonOut bool) {
+	teamKey, _ := cmd.Flags().GetString("team")
+	workspaceOnly, _ := cmd.Flags().GetBool("workspace")
+	if teamKey != "" && workspaceOnly {
+		output.Error("--team and --workspace cannot be used together", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	var filter map[string]interface{}
+	if teamKey != "" {
+		team, err := client.GetTeam(context.Background(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		filter = map[string]interface{}{"team": map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}}
+	} else if workspaceOnly {
+		filter = map[string]interface{}{"team": map[string]interface{}{"null": true}}
+	}
+
+	pageSize, limit := paginationOptions(cmd)
+	labels, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit, labelPages(client, filter))
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list labels: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if streamed {
+		return
+	}
+
+	if len(labels) == 0 {
+		output.Info("No labels found", plaintext, jsonOut)
+		return
+	}
+
+	if jsonOut {
+		output.JSON(labels)
+		return
+	}
+
+	output.Table(buildTable(columns, labels, !plaintext), plaintext, jsonOut)
+	if !plaintext {
+		fmt.Printf("\n%s %d labels\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(labels))
+		printMoreResultsHint(pageInfo)
+	}
+}
+
+func runLabelCreate(cmd *cobra.Command, client labelAPI, name string, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	input := map[string]interface{}{
+		"name": name,
+	}
+
+	teamID := ""
+	if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+		team, err := client.GetTeam(ctx, teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		teamID = team.ID
+		input["teamId"] = teamID
+	}
+
+	if labelColor, _ := cmd.Flags().GetString("color"); labelColor != "" {
+		input["color"] = labelColor
+	}
+	if description, _ := cmd.Flags().GetString("description"); description != "" {
+		input["description"] = description
+	}
+	if group, _ := cmd.Flags().GetBool("group"); group {
+		input["isGroup"] = true
+	}
+	if parent, _ := cmd.Flags().GetString("parent"); parent != "" {
+		group, err := findLabelGroup(ctx, client, teamID, parent)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		input["parentId"] = group.ID
+	}
+
+	label, err := client.CreateLabel(ctx, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to create label: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(label)
+		return
+	}
+	output.Success(fmt.Sprintf("Created label %s (ID: %s)", labelPath(*label), label.ID), plaintext, jsonOut)
+}
+
+func runLabelUpdate(cmd *cobra.Command, client labelAPI, ref string, plaintext, jsonOut bool) {
+	ctx := context.Background()
+	label := findLabelForCommand(cmd, client, ref, plaintext, jsonOut)
+
+	input := make(map[string]interface{})
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		input["name"] = name
+	}
+	if cmd.Flags().Changed("color") {
+		labelColor, _ := cmd.Flags().GetString("color")
+		input["color"] = labelColor
+	}
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		input["description"] = description
+	}
+	if cmd.Flags().Changed("parent") {
+		parent, _ := cmd.Flags().GetString("parent")
+		switch strings.ToLower(strings.TrimSpace(parent)) {
+		case "", "none", "null":
+			input["parentId"] = nil
+		default:
+			teamID := ""
+			if label.Team != nil {
+				teamID = label.Team.ID
+			}
+			group, err := findLabelGroup(ctx, client, teamID, parent)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input["parentId"] = group.ID
+		}
+	}
+
+	if len(input) == 0 {
+		output.Error("No updates specified. Use --name, --color, --description or --parent.", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	updated, err := client.UpdateLabel(ctx, label.ID, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to update label: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(updated)
+		return
+	}
+	output.Success(fmt.Sprintf("Updated label %s", labelPath(*updated)), plaintext, jsonOut)
+}
+
+func runLabelDelete(cmd *cobra.Command, client labelAPI, ref string, plaintext, jsonOut bool) {
+	label := findLabelForCommand(cmd, client, ref, plaintext, jsonOut)
+	name := label.ID
+	if label.Name != "" {
+		name = labelPath(*label)
+	}
+
+	// Confirmation prompt (unless --force, --json or --dry-run)
+	force, _ := cmd.Flags().GetBool("force")
+	if !force && !jsonOut && !dryRunEnabled() {
+		prompt := fmt.Sprintf("Are you sure you want to delete label %s? It will be removed from every issue that has it.", name)
+		if !confirmAction(prompt) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	if err := client.DeleteLabel(context.Background(), label.ID); err != nil {
+		output.Error(fmt.Sprintf("Failed to delete label: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{"success": true, "labelId": label.ID})
+		return
+	}
+	output.Success(fmt.Sprintf("Deleted label %s", name), plaintext, jsonOut)
+}
+
+// findLabelForCommand resolves the LABEL argument of update and delete,
+// scoped by --team when given, exiting on failure
+func findLabelForCommand(cmd *cobra.Command, client labelAPI, ref string, plaintext, jsonOut bool) *api.Label {
+	if isValidUUID(ref) {
+		return &api.Label{ID: ref}
+	}
+
+	ctx := context.Background()
+	var filter map[string]interface{}
+	if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+		team, err := client.GetTeam(ctx, teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		filter = teamLabelFilter(team.ID)
+	}
+
+	labels, _, err := api.Collect(ctx, api.MaxPageSize, 0, labelPages(client, filter))
+	if err == nil {
+		var label *api.Label
+		if label, err = matchLabel(labels, ref); err == nil {
+			return label
+		}
+	}
+	output.Error(err.Error(), plaintext, jsonOut)
+	os.Exit(exitCode(err))
+	return nil
+}
+
+// labelPages adapts GetLabels to api.PageFunc
+func labelPages(client labelLister, filter map[string]interface{}) api.PageFunc[api.Label] {
+	return func(ctx context.Context, first int, after string) ([]api.Label, api.PageInfo, error) {
+		page, err := client.GetLabels(ctx, filter, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	}
+}
+
+// teamLabelFilter matches the labels usable on a team's issues: the team's
+// own labels and workspace labels. An empty team ID matches workspace labels.
+func teamLabelFilter(teamID string) map[string]interface{} {
+	workspace := map[string]interface{}{"team": map[string]interface{}{"null": true}}
+	if teamID == "" {
+		return workspace
+	}
+	return map[string]interface{}{
+		"or": []interface{}{
+			map[string]interface{}{"team": map[string]interface{}{"id": map[string]interface{}{"eq": teamID}}},
+			workspace,
+		},
+	}
+}
+
+// resolveLabelIDs maps label names to the IDs of labels usable by a team.
+// IDs are passed through unchanged and group labels are never matched,
+// since only labels inside a group can be applied to an issue.
+func resolveLabelIDs(ctx context.Context, client labelLister, teamID string, names []string) ([]string, error) {
+	var ids []string
+	var assignable []api.Label
+	fetched := false
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if isValidUUID(name) {
+			ids = append(ids, name)
+			continue
+		}
+
+		if !fetched {
+			labels, _, err := api.Collect(ctx, api.MaxPageSize, 0, labelPages(client, teamLabelFilter(teamID)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list labels: %w", err)
+			}
+			for _, label := range labels {
+				if !label.IsGroup {
+					assignable = append(assignable, label)
+				}
+			}
+			fetched = true
+		}
+
+		label, err := matchLabel(assignable, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, label.ID)
+	}
+	return ids, nil
+}
+
+// findLabelGroup resolves a label group by name or ID among the groups
+// usable by a team
+func findLabelGroup(ctx context.Context, client labelLister, teamID, ref string) (*api.Label, error) {
+	if isValidUUID(ref) {
+		return &api.Label{ID: ref}, nil
+	}
+	labels, _, err := api.Collect(ctx, api.MaxPageSize, 0, labelPages(client, teamLabelFilter(teamID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+	var groups []api.Label
+	for _, label := range labels {
+		if label.IsGroup {
+			groups = append(groups, label)
+		}
+	}
+	group, err := matchLabel(groups, ref)
+	if err != nil {
+		return nil, fmt.Errorf("label group: %w", err)
+	}
+	return group, nil
+}
+
+// matchLabel finds the label named ref, ignoring case. A ref of the form
+// "Group/Name" matches a label inside a group. Multiple matches are an error.
+func matchLabel(labels []api.Label, ref string) (*api.Label, error) {
+	var matches []api.Label
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, ref) || strings.EqualFold(labelPath(label), ref) {
+			matches = append(matches, label)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("label %q %w", ref, api.ErrNotFound)
+	case 1:
+		return &matches[0], nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, label := range matches {
+		scope := "workspace"
+		if label.Team != nil {
+			scope = label.Team.Key
+		}
+		candidates[i] = fmt.Sprintf("%s (%s)", labelPath(label), scope)
+	}
+	return nil, fmt.Errorf("label %q is ambiguous: %s; use Group/Name, --team, or the label ID",
+		ref, strings.Join(candidates, ", "))
+}
+
+// labelPath returns a label's name prefixed by its group, e.g. "Type/Bug"
+func labelPath(label api.Label) string {
+	if label.Parent != nil && label.Parent.Name != "" {
+		return label.Parent.Name + "/" + label.Name
+	}
+	return label.Name
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type mockLabelClient struct {
+	labels  []api.Label
+	filters []map[string]interface{}
+	created map[string]interface{}
+	updated map[string]interface{}
+	deleted string
+}
+
+func (m *mockLabelClient) GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.IssueLabels, error) {
+	m.filters = append(m.filters, filter)
+	return &api.IssueLabels{Nodes: m.labels}, nil
+}
+
+func (m *mockLabelClient) CreateLabel(ctx context.Context, input map[string]interface{}) (*api.Label, error) {
+	m.created = input
+	return &api.Label{ID: "label-new", Name: input["name"].(string)}, nil
+}
+
+func (m *mockLabelClient) UpdateLabel(ctx context.Context, id string, input map[string]interface{}) (*api.Label, error) {
+	m.updated = input
+	return &api.Label{ID: id, Name: "renamed"}, nil
+}
+
+func (m *mockLabelClient) DeleteLabel(ctx context.Context, id string) error {
+	m.deleted = id
+	return nil
+}
+
+func (m *mockLabelClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
+	return &api.Team{ID: "team-" + strings.ToLower(key), Key: key}, nil
+}
+
+func withInjectedLabelClient(t *testing.T, mc *mockLabelClient, fn func()) {
+	t.Helper()
+	oldNew := newLabelAPIClient
+	oldAuth := getLabelAuthHeader
+	newLabelAPIClient = func(_ string) labelAPI { return mc }
+	getLabelAuthHeader = func() (string, error) { return "Bearer test", nil }
+	defer func() { newLabelAPIClient = oldNew; getLabelAuthHeader = oldAuth }()
+	fn()
+}
+
+func sampleLabels() []api.Label {
+	eng := &api.Team{ID: "team-eng", Key: "ENG"}
+	typeGroup := &api.Label{ID: "group-type", Name: "Type"}
+	areaGroup := &api.Label{ID: "group-area", Name: "Area"}
+	return []api.Label{
+		{ID: "group-type", Name: "Type", IsGroup: true},
+		{ID: "group-area", Name: "Area", IsGroup: true},
+		{ID: "label-bug", Name: "Bug", Parent: typeGroup},
+		{ID: "label-frontend", Name: "Frontend", Team: eng},
+		{ID: "label-docs-type", Name: "Docs", Parent: typeGroup},
+		{ID: "label-docs-area", Name: "Docs", Parent: areaGroup},
+	}
+}
+
+func TestResolveLabelIDs(t *testing.T) {
+	mc := &mockLabelClient{labels: sampleLabels()}
+	uuid := "123e4567-e89b-12d3-a456-426614174000"
+
+	ids, err := resolveLabelIDs(context.Background(), mc, "team-eng", []string{"bug", "FRONTEND", "Area/Docs", uuid})
+	if err != nil {
+		t.Fatalf("resolveLabelIDs() error = %v", err)
+	}
+	want := []string{"label-bug", "label-frontend", "label-docs-area", uuid}
+	if strings.Join(ids, ",") != strings.Join(want, ",") {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+
+	filter := mc.filters[0]
+	if _, ok := filter["or"]; !ok {
+		t.Fatalf("filter = %v, want team labels or workspace labels", filter)
+	}
+}
+
+func TestResolveLabelIDsErrors(t *testing.T) {
+	mc := &mockLabelClient{labels: sampleLabels()}
+
+	_, err := resolveLabelIDs(context.Background(), mc, "", []string{"missing"})
+	if !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("error = %v, want ErrNotFound", err)
+	}
+
+	_, err = resolveLabelIDs(context.Background(), mc, "", []string{"Docs"})
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "Type/Docs") {
+		t.Fatalf("error = %v, want ambiguity listing Type/Docs", err)
+	}
+
+	// Groups cannot be applied to issues
+	_, err = resolveLabelIDs(context.Background(), mc, "", []string{"Type"})
+	if !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("error = %v, want ErrNotFound for a label group", err)
+	}
+}
+
+func TestBuildIssueFilterLabels(t *testing.T) {
+	newCmd := func(labels ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().StringSlice("label", nil, "")
+		cmd.Flags().Int("priority", -1, "")
+		cmd.Flags().Bool("include-completed", true, "")
+		for _, label := range labels {
+			_ = cmd.Flags().Set("label", label)
+		}
+		return cmd
+	}
+
+	filter := buildIssueFilter(newCmd("bug"))
+	labels, ok := filter["labels"].(map[string]interface{})
+	if !ok || labels["some"] == nil {
+		t.Fatalf("filter = %v, want labels.some", filter)
+	}
+
+	filter = buildIssueFilter(newCmd("bug", "frontend"))
+	and, ok := filter["and"].([]interface{})
+	if !ok || len(and) != 2 {
+		t.Fatalf("filter = %v, want two label conditions", filter)
+	}
+}
+
+func TestLabelCreateInGroup(t *testing.T) {
+	mc := &mockLabelClient{labels: sampleLabels()}
+	withInjectedLabelClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		defer func() {
+			_ = labelCreateCmd.Flags().Set("team", "")
+			_ = labelCreateCmd.Flags().Set("parent", "")
+		}()
+		_ = labelCreateCmd.Flags().Set("team", "ENG")
+		_ = labelCreateCmd.Flags().Set("parent", "type")
+		out := captureMilestoneStdout(t, func() {
+			labelCreateCmd.Run(labelCreateCmd, []string{"Regression"})
+		})
+		if !contains(out, "Created label Regression") {
+			t.Fatalf("unexpected output:\n%s", out)
+		}
+		if mc.created["teamId"] != "team-eng" || mc.created["parentId"] != "group-type" {
+			t.Fatalf("create input = %v", mc.created)
+		}
+	})
+}
+
+func TestLabelUpdateAndDeleteByName(t *testing.T) {
+	mc := &mockLabelClient{labels: sampleLabels()}
+	withInjectedLabelClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		defer func() { _ = labelUpdateCmd.Flags().Set("name", "") }()
+		_ = labelUpdateCmd.Flags().Set("name", "Defect")
+		captureMilestoneStdout(t, func() {
+			labelUpdateCmd.Run(labelUpdateCmd, []string{"Type/Bug"})
+		})
+		if mc.updated["name"] != "Defect" {
+			t.Fatalf("update input = %v", mc.updated)
+		}
+
+		defer func() { _ = labelDeleteCmd.Flags().Set("force", "false") }()
+		_ = labelDeleteCmd.Flags().Set("force", "true")
+		out := captureMilestoneStdout(t, func() {
+			labelDeleteCmd.Run(labelDeleteCmd, []string{"frontend"})
+		})
+		if mc.deleted != "label-frontend" || !contains(out, "Deleted label Frontend") {
+			t.Fatalf("deleted = %q, output:\n%s", mc.deleted, out)
+		}
+	})
+}
+
+func TestLabelDeleteDeclined(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, false, &prompts)
+
+	mc := &mockLabelClient{labels: sampleLabels()}
+	cmd := &cobra.Command{}
+	cmd.Flags().String("team", "", "")
+	cmd.Flags().Bool("force", false, "")
+	out := captureMilestoneStdout(t, func() {
+		runLabelDelete(cmd, mc, "Type/Bug", true, false)
+	})
+
+	if len(prompts) != 1 || !contains(prompts[0], "delete label Type/Bug?") {
+		t.Fatalf("prompts = %q", prompts)
+	}
+	if mc.deleted != "" || !contains(out, "Cancelled.") {
+		t.Fatalf("deleted %q after declining", mc.deleted)
+	}
+}
@@ -44,7 +44,7 @@ var milestoneListCmd = &cobra.Command{
 		authHeader, err := getMilestoneAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := newMilestoneAPIClient(authHeader)
@@ -64,7 +64,7 @@ var milestoneGetCmd = &cobra.Command{
 		authHeader, err := getMilestoneAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := newMilestoneAPIClient(authHeader)
@@ -83,7 +83,7 @@ var milestoneCreateCmd = &cobra.Command{
 		authHeader, err := getMilestoneAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := newMilestoneAPIClient(authHeader)
@@ -103,7 +103,7 @@ var milestoneUpdateCmd = &cobra.Command{
 		authHeader, err := getMilestoneAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := newMilestoneAPIClient(authHeader)
@@ -123,7 +123,7 @@ var milestoneDeleteCmd = &cobra.Command{
 		authHeader, err := getMilestoneAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := newMilestoneAPIClient(authHeader)
@@ -162,7 +162,7 @@ func runMilestoneList(cmd *cobra.Command, client milestoneAPI, projectID string,
 	milestones, err := client.ListProjectMilestones(context.Background(), projectID, includeArchived)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if len(milestones.Nodes) == 0 {
@@ -209,7 +209,7 @@ func runMilestoneGet(cmd *cobra.Command, client milestoneAPI, milestoneID string
 	milestone, err := client.GetProjectMilestone(context.Background(), milestoneID)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to get milestone: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if jsonOut {
@@ -249,7 +249,7 @@ func runMilestoneCreate(cmd *cobra.Command, client milestoneAPI, plaintext, json
 	if targetDate != "" {
 		if _, err := time.Parse("2006-01-02", targetDate); err != nil {
 			output.Error("Invalid --target-date format. Expected YYYY-MM-DD", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 	}
 
@@ -270,7 +270,7 @@ func runMilestoneCreate(cmd *cobra.Command, client milestoneAPI, plaintext, json
 	milestone, err := client.CreateProjectMilestone(context.Background(), input)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to create milestone: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if jsonOut {
@@ -304,7 +304,7 @@ func runMilestoneUpdate(cmd *cobra.Command, client milestoneAPI, milestoneID str
 		if targetDate != "" {
 			if _, err := time.Parse("2006-01-02", targetDate); err != nil {
 				output.Error("Invalid --target-date format. Expected YYYY-MM-DD", plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 		input["targetDate"] = targetDate
@@ -313,14 +313,14 @@ func runMilestoneUpdate(cmd *cobra.Command, client milestoneAPI, milestoneID str
 	// Validate at least one field provided
 	if len(input) == 0 {
 		output.Error("At least one field to update is required", plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitUsage)
 	}
 
 	// Update milestone
 	milestone, err := client.UpdateProjectMilestone(context.Background(), milestoneID, input)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to update milestone: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if jsonOut {
@@ -336,7 +336,7 @@ func runMilestoneDelete(cmd *cobra.Command, client milestoneAPI, milestoneID str
 	err := client.DeleteProjectMilestone(context.Background(), milestoneID)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to delete milestone: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	if jsonOut {
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addPaginationFlags registers --all and --page-size on a list command.
+// The command is expected to define its own --limit flag.
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("all", false, "Fetch every page (ignores --limit)")
+	cmd.Flags().Int("page-size", api.DefaultPageSize, "Number of results to request per page (max 250)")
+}
+
+// paginationOptions returns the page size and total cap for a list command.
+// A cap of zero means every page is fetched.
+func paginationOptions(cmd *cobra.Command) (pageSize, limit int) {
+	pageSize, _ = cmd.Flags().GetInt("page-size")
+	limit, _ = cmd.Flags().GetInt("limit")
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		limit = 0
+	} else if limit <= 0 {
+		limit = api.DefaultPageSize
+	}
+	return pageSize, limit
+}
+
+// collectPages fetches pages like api.Collect. With --output ndjson each page
+// is written as soon as it arrives and streamed is true; the caller should
+// then skip its own output.
+func collectPages[T any](ctx context.Context, pageSize, limit int, fetch api.PageFunc[T]) (nodes []T, pageInfo api.PageInfo, streamed bool, err error) {
+	if !output.Streaming() {
+		nodes, pageInfo, err = api.Collect(ctx, pageSize, limit, fetch)
+		return nodes, pageInfo, false, err
+	}
+
+	for page, err := range api.Pages(ctx, pageSize, limit, fetch) {
+		if err != nil {
+			return nodes, pageInfo, true, err
+		}
+		if len(page.Nodes) > 0 {
+			output.JSON(page.Nodes)
+		}
+		nodes = append(nodes, page.Nodes...)
+		pageInfo = page.PageInfo
+	}
+	return nodes, pageInfo, true, nil
+}
+
+// printMoreResultsHint tells the user how to fetch the rest of a truncated listing
+func printMoreResultsHint(pageInfo api.PageInfo) {
+	if pageInfo.HasNextPage {
+		fmt.Printf("%s Use --limit or --all to see more results\n",
+			color.New(color.FgYellow).Sprint("ℹ️"))
+	}
+}
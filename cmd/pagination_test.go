@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+func newPaginatedTestCmd() *cobra.Command {
+	c := &cobra.Command{Use: "list"}
+	c.Flags().Int("limit", 50, "")
+	addPaginationFlags(c)
+	return c
+}
+
+func TestPaginationOptions(t *testing.T) {
+	c := newPaginatedTestCmd()
+	if pageSize, limit := paginationOptions(c); pageSize != 50 || limit != 50 {
+		t.Fatalf("defaults: got (%d, %d), want (50, 50)", pageSize, limit)
+	}
+
+	c = newPaginatedTestCmd()
+	_ = c.Flags().Set("limit", "120")
+	_ = c.Flags().Set("page-size", "100")
+	if pageSize, limit := paginationOptions(c); pageSize != 100 || limit != 120 {
+		t.Fatalf("explicit: got (%d, %d), want (100, 120)", pageSize, limit)
+	}
+
+	c = newPaginatedTestCmd()
+	_ = c.Flags().Set("all", "true")
+	if _, limit := paginationOptions(c); limit != 0 {
+		t.Fatalf("--all: got limit %d, want 0 (no cap)", limit)
+	}
+}
+
+func TestListCommandsHavePaginationFlags(t *testing.T) {
+	for _, c := range []*cobra.Command{issueListCmd, issueSearchCmd, projectListCmd, teamListCmd, userListCmd, commentListCmd} {
+		for _, name := range []string{"all", "page-size", "limit"} {
+			if c.Flags().Lookup(name) == nil {
+				t.Errorf("%s is missing --%s", c.CommandPath(), name)
+			}
+		}
+	}
+}
+
+func TestCollectPagesStreamsNDJSON(t *testing.T) {
+	if err := output.SetMode(output.ModeNDJSON); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = output.SetMode("") }()
+
+	// Write stdout to a file so the test can see what was printed between pages
+	stdout, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdout
+	os.Stdout = stdout
+	defer func() { os.Stdout = orig }()
+
+	var beforeSecondPage []byte
+	fetch := func(ctx context.Context, first int, after string) ([]api.Team, api.PageInfo, error) {
+		if after == "" {
+			return []api.Team{{Key: "ENG"}}, api.PageInfo{HasNextPage: true, EndCursor: "c1"}, nil
+		}
+		beforeSecondPage, _ = os.ReadFile(stdout.Name())
+		return []api.Team{{Key: "OPS"}}, api.PageInfo{}, nil
+	}
+
+	nodes, _, streamed, err := collectPages(context.Background(), 1, 0, fetch)
+	os.Stdout = orig
+	if err != nil || !streamed || len(nodes) != 2 {
+		t.Fatalf("collectPages: nodes=%d streamed=%v err=%v", len(nodes), streamed, err)
+	}
+
+	if !strings.Contains(string(beforeSecondPage), `"key":"ENG"`) {
+		t.Fatalf("expected the first page to be written before the second was fetched, got %q", beforeSecondPage)
+	}
+	out, _ := os.ReadFile(stdout.Name())
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], `"key":"OPS"`) {
+		t.Fatalf("expected one NDJSON line per team, got %q", out)
+	}
+}
+
+func TestConfigureOutputRejectsFormatWithOutput(t *testing.T) {
+	format, outputFmt = "{{.ID}}", "csv"
+	defer func() {
+		format, outputFmt = "", ""
+		_ = configureOutput()
+	}()
+	if err := configureOutput(); err == nil {
+		t.Fatal("expected --format and --output to conflict")
+	}
+}
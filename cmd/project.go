@@ -55,12 +55,13 @@ var projectListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		columns, customColumns := selectColumns(cmd, projectColumns, plaintext, jsonOut)
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -69,7 +70,6 @@ var projectListCmd = &cobra.Command{
 		// Get filters
 		teamKey, _ := cmd.Flags().GetString("team")
 		state, _ := cmd.Flags().GetString("state")
-		limit, _ := cmd.Flags().GetInt("limit")
 		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
 
 		// Build filter
@@ -79,7 +79,7 @@ var projectListCmd = &cobra.Command{
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			filter["team"] = map[string]interface{}{"id": team.ID}
 		}
@@ -97,7 +97,7 @@ var projectListCmd = &cobra.Command{
 		createdAt, err := utils.ParseTimeExpression(newerThan)
 		if err != nil {
 			output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 		if createdAt != "" {
 			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -117,21 +117,36 @@ var projectListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
 		// Get projects
-		projects, err := client.GetProjects(context.Background(), filter, limit, "", orderBy)
+		pageSize, limit := paginationOptions(cmd)
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Project, api.PageInfo, error) {
+				page, err := client.GetProjects(ctx, filter, first, after, orderBy)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		if streamed {
+			return
 		}
+		projects := &api.Projects{Nodes: nodes, PageInfo: pageInfo}
 
 		// Handle output
 		if jsonOut {
 			output.JSON(projects.Nodes)
 			return
+		} else if plaintext && customColumns {
+			output.Table(buildTable(columns, projects.Nodes, false), true, false)
+			return
 		} else if plaintext {
 			fmt.Println("# Projects")
 			for _, project := range projects.Nodes {
@@ -171,65 +186,82 @@ var projectListCmd = &cobra.Command{
 			fmt.Printf("\nTotal: %d projects\n", len(projects.Nodes))
 			return
 		} else {
-			// Table output
-			headers := []string{"Name", "State", "Lead", "Teams", "Created", "Updated", "URL"}
-			rows := [][]string{}
-
-			for _, project := range projects.Nodes {
-				lead := color.New(color.FgYellow).Sprint("Unassigned")
-				if project.Lead != nil {
-					lead = project.Lead.Name
-				}
-
-				teams := ""
-				if project.Teams != nil && len(project.Teams.Nodes) > 0 {
-					for i, team := range project.Teams.Nodes {
-						if i > 0 {
-							teams += ", "
-						}
-						teams += team.Key
-					}
-				}
-
-				stateColor := color.New(color.FgGreen)
-				switch project.State {
-				case "planned":
-					stateColor = color.New(color.FgCyan)
-				case "started":
-					stateColor = color.New(color.FgBlue)
-				case "paused":
-					stateColor = color.New(color.FgYellow)
-				case "completed":
-					stateColor = color.New(color.FgGreen)
-				case "canceled":
-					stateColor = color.New(color.FgRed)
-				}
-
-				rows = append(rows, []string{
-					truncateString(project.Name, 25),
-					stateColor.Sprint(project.State),
-					lead,
-					teams,
-					project.CreatedAt.Format("2006-01-02"),
-					project.UpdatedAt.Format("2006-01-02"),
-					constructProjectURL(project.ID, project.URL),
-				})
-			}
-
-			output.Table(output.TableData{
-				Headers: headers,
-				Rows:    rows,
-			}, plaintext, jsonOut)
+			output.Table(buildTable(columns, projects.Nodes, true), plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
 				fmt.Printf("\n%s %d projects\n",
 					color.New(color.FgGreen).Sprint("✓"),
 					len(projects.Nodes))
+				printMoreResultsHint(projects.PageInfo)
 			}
 		}
 	},
 }
 
+// projectColumns are the table columns available to project list
+var projectColumns = columnSet[api.Project]{
+	columns: []tableColumn[api.Project]{
+		{Name: "id", Header: "ID", Value: func(p api.Project) string { return p.ID }},
+		{Name: "name", Header: "Name", Flex: true, Value: func(p api.Project) string { return p.Name }},
+		{Name: "state", Header: "State", Color: projectStateColor, Value: func(p api.Project) string { return p.State }},
+		{Name: "lead", Header: "Lead", Value: func(p api.Project) string {
+			if p.Lead == nil {
+				return "Unassigned"
+			}
+			return p.Lead.Name
+		}, Color: func(p api.Project) *color.Color {
+			if p.Lead == nil {
+				return color.New(color.FgYellow)
+			}
+			return nil
+		}},
+		{Name: "teams", Header: "Teams", Flex: true, Value: func(p api.Project) string {
+			if p.Teams == nil {
+				return ""
+			}
+			keys := make([]string, len(p.Teams.Nodes))
+			for i, team := range p.Teams.Nodes {
+				keys[i] = team.Key
+			}
+			return strings.Join(keys, ", ")
+		}},
+		{Name: "progress", Header: "Progress", Value: func(p api.Project) string { return fmt.Sprintf("%.0f%%", p.Progress*100) }},
+		{Name: "start", Header: "Start", Value: func(p api.Project) string {
+			if p.StartDate == nil {
+				return ""
+			}
+			return *p.StartDate
+		}},
+		{Name: "target", Header: "Target", Value: func(p api.Project) string {
+			if p.TargetDate == nil {
+				return ""
+			}
+			return *p.TargetDate
+		}},
+		{Name: "created", Header: "Created", Value: func(p api.Project) string { return p.CreatedAt.Format("2006-01-02") }},
+		{Name: "updated", Header: "Updated", Value: func(p api.Project) string { return p.UpdatedAt.Format("2006-01-02") }},
+		{Name: "url", Header: "URL", Value: func(p api.Project) string { return constructProjectURL(p.ID, p.URL) }},
+	},
+	defaults: []string{"name", "state", "lead", "teams", "created", "updated", "url"},
+	wide:     []string{"name", "state", "lead", "teams", "progress", "start", "target", "created", "updated", "url"},
+}
+
+// projectStateColor picks the color for a project's state
+func projectStateColor(project api.Project) *color.Color {
+	switch project.State {
+	case "planned":
+		return color.New(color.FgCyan)
+	case "started":
+		return color.New(color.FgBlue)
+	case "paused":
+		return color.New(color.FgYellow)
+	case "canceled":
+		return color.New(color.FgRed)
+	default:
+		return color.New(color.FgGreen)
+	}
+}
+
 var projectGetCmd = &cobra.Command{
 	Use:     "get PROJECT-ID",
 	Aliases: []string{"show"},
@@ -245,7 +277,7 @@ var projectGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -255,7 +287,7 @@ var projectGetCmd = &cobra.Command{
 		project, err := client.GetProject(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -600,7 +632,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
@@ -611,12 +643,12 @@ Examples:
 
 		if name == "" {
 			output.Error("Project name is required (--name)", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		if len(teamKeys) == 0 {
 			output.Error("At least one team is required (--team)", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		// Build input
@@ -630,7 +662,7 @@ Examples:
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			teamIDs = append(teamIDs, team.ID)
 		}
@@ -655,7 +687,7 @@ Examples:
 			}
 			if !isValid {
 				output.Error(fmt.Sprintf("Invalid state '%s'. Valid states: %s", state, strings.Join(validStates, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
@@ -665,14 +697,14 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				input["leadId"] = viewer.ID
 			} else {
 				users, err := client.GetUsers(context.Background(), 100, "", "")
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				var foundUser *api.User
 				for _, user := range users.Nodes {
@@ -683,7 +715,7 @@ Examples:
 				}
 				if foundUser == nil {
 					output.Error(fmt.Sprintf("User not found: %s", leadValue), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitNotFound)
 				}
 				input["leadId"] = foundUser.ID
 			}
@@ -708,7 +740,7 @@ Examples:
 		project, err := client.CreateProject(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Output
@@ -749,7 +781,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
@@ -780,7 +812,7 @@ Examples:
 			}
 			if !isValid {
 				output.Error(fmt.Sprintf("Invalid state '%s'. Valid states: %s", state, strings.Join(validStates, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
@@ -793,14 +825,14 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				input["leadId"] = viewer.ID
 			default:
 				users, err := client.GetUsers(context.Background(), 100, "", "")
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				var foundUser *api.User
 				for _, user := range users.Nodes {
@@ -811,7 +843,7 @@ Examples:
 				}
 				if foundUser == nil {
 					output.Error(fmt.Sprintf("User not found: %s", leadValue), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitNotFound)
 				}
 				input["leadId"] = foundUser.ID
 			}
@@ -843,14 +875,14 @@ Examples:
 		// Check if any updates were specified
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		// Update project
 		project, err := client.UpdateProject(context.Background(), projectID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Output
@@ -888,7 +920,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		client := api.NewClient(authHeader)
@@ -900,11 +932,11 @@ Examples:
 		project, err := client.GetProject(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to find project '%s': %v", projectID, err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
-		// Confirmation prompt (unless --force or --json)
-		if !force && !jsonOut {
+		// Confirmation prompt (unless --force, --json or --dry-run)
+		if !force && !jsonOut && !dryRunEnabled() {
 			action := "archive"
 			if permanent {
 				action = "PERMANENTLY DELETE"
@@ -926,7 +958,7 @@ Examples:
 			err = client.DeleteProject(context.Background(), projectID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to delete project: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 
 			if jsonOut {
@@ -948,7 +980,7 @@ Examples:
 			archivedProject, err := client.ArchiveProject(context.Background(), projectID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to archive project: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 
 			if jsonOut {
@@ -994,7 +1026,7 @@ Examples:
 		// Validate body is provided
 		if body == "" {
 			output.Error("--body is required", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		// Validate health if provided
@@ -1009,7 +1041,7 @@ Examples:
 			}
 			if !valid {
 				output.Error(fmt.Sprintf("Invalid health. Must be one of: %s", strings.Join(allowedHealth, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
@@ -1017,7 +1049,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1036,7 +1068,7 @@ Examples:
 		update, err := client.CreateProjectUpdate(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1084,7 +1116,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1094,7 +1126,7 @@ Examples:
 		updates, err := client.ListProjectUpdates(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list project updates: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if len(updates.Nodes) == 0 {
@@ -1161,7 +1193,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1171,7 +1203,7 @@ Examples:
 		update, err := client.GetProjectUpdate(context.Background(), updateID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1193,6 +1225,9 @@ Examples:
 			if update.EditedAt != nil {
 				fmt.Printf("Edited: %s\n", update.EditedAt.Format("2006-01-02 15:04:05"))
 			}
+			if len(update.Reactions) > 0 {
+				fmt.Printf("Reactions: %s\n", reactionSummary(update.Reactions, true))
+			}
 			fmt.Println()
 			fmt.Println("Body:")
 			fmt.Println(update.Body)
@@ -1210,6 +1245,9 @@ Examples:
 			if update.EditedAt != nil {
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Edited:"), update.EditedAt.Format("2006-01-02 15:04:05"))
 			}
+			if len(update.Reactions) > 0 {
+				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Reactions:"), reactionSummary(update.Reactions, false))
+			}
 			fmt.Println()
 			fmt.Println(color.New(color.Bold).Sprint("Body:"))
 			fmt.Println(update.Body)
@@ -1239,6 +1277,8 @@ func init() {
 	projectListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled projects")
 	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	addPaginationFlags(projectListCmd)
+	addColumnFlags(projectListCmd, projectColumns)
 
 	// Create command flags
 	projectCreateCmd.Flags().String("name", "", "Project name (required)")
@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// reactAPI defines the interface for adding and removing reactions
+type reactAPI interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	GetComment(ctx context.Context, id string) (*api.Comment, error)
+	GetProjectUpdate(ctx context.Context, updateID string) (*api.ProjectUpdate, error)
+	GetViewer(ctx context.Context) (*api.User, error)
+	CreateReaction(ctx context.Context, input map[string]interface{}) (*api.Reaction, error)
+	DeleteReaction(ctx context.Context, id string) error
+}
+
+// Injection points for testing
+var newReactAPIClient = func(authHeader string) reactAPI { return api.NewClient(authHeader) }
+var getReactAuthHeader = auth.GetAuthHeader
+
+// reactionShortcodes pairs the shortcodes Linear stores reactions under with
+// their emoji. The first shortcode listed for an emoji is the one sent.
+var reactionShortcodes = [][2]string{
+	{"+1", "👍"}, {"thumbsup", "👍"},
+	{"-1", "👎"}, {"thumbsdown", "👎"},
+	{"tada", "🎉"},
+	{"heart", "❤️"},
+	{"eyes", "👀"},
+	{"rocket", "🚀"},
+	{"smile", "😄"},
+	{"laughing", "😆"},
+	{"confused", "😕"},
+	{"thinking_face", "🤔"},
+	{"fire", "🔥"},
+	{"100", "💯"},
+	{"pray", "🙏"},
+	{"clap", "👏"},
+	{"raised_hands", "🙌"},
+	{"white_check_mark", "✅"},
+}
+
+// reactionTarget is the issue, comment or project update being reacted to
+type reactionTarget struct {
+	field     string // the ReactionCreateInput field naming the target
+	id        string
+	name      string
+	reactions []api.Reaction
+}
+
+var reactCmd = &cobra.Command{
+	Use:   "react [ISSUE] EMOJI",
+	Short: "Add or remove an emoji reaction",
+	Long: `React to an issue, a comment or a project update with an emoji, given as
+a shortcode (:+1:, tada) or the emoji itself. Reacting twice with the same
+emoji is a no-op; use --remove to take a reaction back.
+
+Examples:
+  linctl react ENG-123 :+1:
+  linctl react --comment COMMENT-ID 🎉
+  linctl react --project-update UPDATE-ID rocket
+  linctl react ENG-123 :+1: --remove`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getReactAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		runReact(cmd, newReactAPIClient(authHeader), args, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reactCmd)
+
+	reactCmd.Flags().String("comment", "", "React to this comment instead of an issue")
+	reactCmd.Flags().String("project-update", "", "React to this project update instead of an issue")
+	reactCmd.Flags().Bool("remove", false, "Remove your reaction instead of adding it")
+}
+
+func runReact(cmd *cobra.Command, client reactAPI, args []string, plaintext, jsonOut bool) {
+	commentID, _ := cmd.Flags().GetString("comment")
+	updateID, _ := cmd.Flags().GetString("project-update")
+	remove, _ := cmd.Flags().GetBool("remove")
+
+	wantArgs := 2
+	if commentID != "" || updateID != "" {
+		wantArgs = 1
+	}
+	if commentID != "" && updateID != "" {
+		output.Error("Use only one of --comment and --project-update", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	if len(args) != wantArgs {
+		output.Error("Usage: linctl react ISSUE EMOJI, or linctl react --comment ID EMOJI", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	emoji := normalizeEmoji(args[len(args)-1])
+	if emoji == "" {
+		output.Error("An emoji is required", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	target, err := fetchReactionTarget(ctx, client, args[0], commentID, updateID)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to find %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	// The viewer's existing reaction with this emoji, if any
+	var existing *api.Reaction
+	for i, reaction := range target.reactions {
+		if reaction.User != nil && reaction.User.ID == viewer.ID && normalizeEmoji(reaction.Emoji) == emoji {
+			existing = &target.reactions[i]
+			break
+		}
+	}
+
+	if remove {
+		if existing == nil {
+			err := fmt.Errorf("no :%s: reaction of yours on %s %w", emoji, target.name, api.ErrNotFound)
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := client.DeleteReaction(ctx, existing.ID); err != nil {
+			output.Error(fmt.Sprintf("Failed to remove reaction: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"success": true,
+				"removed": existing,
+			})
+		} else if plaintext {
+			fmt.Printf("Removed :%s: from %s\n", emoji, target.name)
+		} else {
+			fmt.Printf("%s Removed %s from %s\n",
+				color.New(color.FgYellow).Sprint("↺"),
+				emojiGlyph(emoji),
+				color.New(color.FgCyan, color.Bold).Sprint(target.name))
+		}
+		return
+	}
+
+	reaction := existing
+	if reaction == nil {
+		reaction, err = client.CreateReaction(ctx, map[string]interface{}{
+			"emoji":      emoji,
+			target.field: target.id,
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to add reaction: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+	}
+
+	if jsonOut {
+		output.JSON(reaction)
+	} else if plaintext {
+		if existing != nil {
+			fmt.Printf("Already reacted :%s: on %s\n", emoji, target.name)
+		} else {
+			fmt.Printf("Reacted :%s: on %s\n", emoji, target.name)
+		}
+	} else if existing != nil {
+		fmt.Printf("%s You already reacted %s on %s\n",
+			color.New(color.FgYellow).Sprint("ℹ️"),
+			emojiGlyph(emoji),
+			color.New(color.FgCyan, color.Bold).Sprint(target.name))
+	} else {
+		fmt.Printf("%s Reacted %s on %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			emojiGlyph(emoji),
+			color.New(color.FgCyan, color.Bold).Sprint(target.name))
+	}
+}
+
+// fetchReactionTarget looks up the comment, project update or issue being
+// reacted to, along with its current reactions
+func fetchReactionTarget(ctx context.Context, client reactAPI, issueRef, commentID, updateID string) (*reactionTarget, error) {
+	switch {
+	case commentID != "":
+		comment, err := client.GetComment(ctx, commentID)
+		if err != nil {
+			return nil, fmt.Errorf("comment '%s': %w", commentID, err)
+		}
+		name := "comment"
+		if comment.Issue != nil {
+			name = "comment on " + comment.Issue.Identifier
+		}
+		return &reactionTarget{field: "commentId", id: comment.ID, name: name, reactions: comment.Reactions}, nil
+	case updateID != "":
+		update, err := client.GetProjectUpdate(ctx, updateID)
+		if err == nil && update.ID == "" {
+			err = fmt.Errorf("project update %q %w", updateID, api.ErrNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("project update '%s': %w", updateID, err)
+		}
+		return &reactionTarget{field: "projectUpdateId", id: update.ID, name: "project update", reactions: update.Reactions}, nil
+	default:
+		issue, err := client.GetIssue(ctx, issueRef)
+		if err == nil && issue.ID == "" {
+			err = fmt.Errorf("issue %q %w", issueRef, api.ErrNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("issue '%s': %w", issueRef, err)
+		}
+		return &reactionTarget{field: "issueId", id: issue.ID, name: issue.Identifier, reactions: issue.Reactions}, nil
+	}
+}
+
+// normalizeEmoji turns ":+1:", "thumbsup" and "👍" into the shortcode Linear
+// stores. Emoji without a known shortcode are passed through.
+func normalizeEmoji(emoji string) string {
+	emoji = strings.Trim(strings.TrimSpace(emoji), ":")
+	for _, pair := range reactionShortcodes {
+		if emoji == pair[0] || emoji == pair[1] || emoji == strings.TrimSuffix(pair[1], "️") {
+			return canonicalShortcode(pair[1])
+		}
+	}
+	return emoji
+}
+
+// canonicalShortcode returns the first shortcode listed for a known emoji
+func canonicalShortcode(glyph string) string {
+	for _, pair := range reactionShortcodes {
+		if pair[1] == glyph {
+			return pair[0]
+		}
+	}
+	return glyph
+}
+
+// emojiGlyph shows a stored reaction as its emoji when the shortcode is known
+func emojiGlyph(emoji string) string {
+	for _, pair := range reactionShortcodes {
+		if emoji == pair[0] {
+			return pair[1]
+		}
+	}
+	if emoji != "" && emoji[0] < 0x80 {
+		return ":" + emoji + ":"
+	}
+	return emoji
+}
+
+// reactionGroup is one emoji with everyone who reacted with it
+type reactionGroup struct {
+	Emoji string
+	Count int
+	Users []string
+}
+
+// groupReactions groups reactions by emoji, in order of first use
+func groupReactions(reactions []api.Reaction) []reactionGroup {
+	var groups []reactionGroup
+	index := map[string]int{}
+	for _, reaction := range reactions {
+		emoji := normalizeEmoji(reaction.Emoji)
+		i, ok := index[emoji]
+		if !ok {
+			i = len(groups)
+			index[emoji] = i
+			groups = append(groups, reactionGroup{Emoji: emoji})
+		}
+		groups[i].Count++
+		if reaction.User != nil {
+			groups[i].Users = append(groups[i].Users, reaction.User.Name)
+		}
+	}
+	return groups
+}
+
+// reactionSummary counts reactions per emoji, e.g. "👍 2  🎉 1", or in
+// plaintext "+1 (2), tada (1)"
+func reactionSummary(reactions []api.Reaction, plaintext bool) string {
+	groups := groupReactions(reactions)
+	parts := make([]string, len(groups))
+	for i, group := range groups {
+		if plaintext {
+			parts[i] = fmt.Sprintf("%s (%d)", group.Emoji, group.Count)
+		} else {
+			parts[i] = fmt.Sprintf("%s %d", emojiGlyph(group.Emoji), group.Count)
+		}
+	}
+	if plaintext {
+		return strings.Join(parts, ", ")
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockReactClient struct {
+	issue   *api.Issue
+	comment *api.Comment
+	created []map[string]interface{}
+	deleted []string
+}
+
+func (m *mockReactClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	return m.issue, nil
+}
+
+func (m *mockReactClient) GetComment(ctx context.Context, id string) (*api.Comment, error) {
+	return m.comment, nil
+}
+
+func (m *mockReactClient) GetProjectUpdate(ctx context.Context, updateID string) (*api.ProjectUpdate, error) {
+	return &api.ProjectUpdate{}, nil
+}
+
+func (m *mockReactClient) GetViewer(ctx context.Context) (*api.User, error) {
+	return &api.User{ID: "user-me", Name: "Me"}, nil
+}
+
+func (m *mockReactClient) CreateReaction(ctx context.Context, input map[string]interface{}) (*api.Reaction, error) {
+	m.created = append(m.created, input)
+	return &api.Reaction{ID: "reaction-new", Emoji: input["emoji"].(string)}, nil
+}
+
+func (m *mockReactClient) DeleteReaction(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func newReactCommand(t *testing.T, flags map[string]string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("comment", "", "")
+	cmd.Flags().String("project-update", "", "")
+	cmd.Flags().Bool("remove", false, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+	return cmd
+}
+
+func TestNormalizeEmoji(t *testing.T) {
+	for input, want := range map[string]string{
+		":+1:":         "+1",
+		"👍":            "+1",
+		"tada":         "tada",
+		"thumbsup":     "+1",
+		":thumbsdown:": "-1",
+		"❤️":           "heart",
+		"❤":            "heart",
+		":owl:":        "owl",
+		"🦉":            "🦉",
+	} {
+		if got := normalizeEmoji(input); got != want {
+			t.Errorf("normalizeEmoji(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestReactToComment(t *testing.T) {
+	mc := &mockReactClient{comment: &api.Comment{ID: "c-1", Issue: &api.Issue{Identifier: "ENG-1"}}}
+	out := captureMilestoneStdout(t, func() {
+		runReact(newReactCommand(t, map[string]string{"comment": "c-1"}), mc, []string{"🎉"}, true, false)
+	})
+
+	want := []map[string]interface{}{{"emoji": "tada", "commentId": "c-1"}}
+	if !reflect.DeepEqual(mc.created, want) {
+		t.Fatalf("created %v, want %v", mc.created, want)
+	}
+	if out != "Reacted :tada: on comment on ENG-1\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestReactIsIdempotentAndRemoves(t *testing.T) {
+	mc := &mockReactClient{issue: &api.Issue{ID: "issue-1", Identifier: "ENG-1", Reactions: []api.Reaction{
+		{ID: "r-other", Emoji: "+1", User: &api.User{ID: "user-ada"}},
+		{ID: "r-mine", Emoji: "+1", User: &api.User{ID: "user-me"}},
+	}}}
+
+	out := captureMilestoneStdout(t, func() {
+		runReact(newReactCommand(t, nil), mc, []string{"ENG-1", ":+1:"}, true, false)
+	})
+	if len(mc.created) != 0 || out != "Already reacted :+1: on ENG-1\n" {
+		t.Fatalf("created %v, output %q", mc.created, out)
+	}
+
+	captureMilestoneStdout(t, func() {
+		runReact(newReactCommand(t, map[string]string{"remove": "true"}), mc, []string{"ENG-1", "👍"}, true, false)
+	})
+	if !reflect.DeepEqual(mc.deleted, []string{"r-mine"}) {
+		t.Fatalf("deleted %v, want only the viewer's reaction", mc.deleted)
+	}
+}
+
+func TestReactionSummary(t *testing.T) {
+	reactions := []api.Reaction{
+		{Emoji: "+1", User: &api.User{Name: "Ada"}},
+		{Emoji: "tada", User: &api.User{Name: "Grace"}},
+		{Emoji: "👍", User: &api.User{Name: "Linus"}},
+	}
+	if got := reactionSummary(reactions, true); got != "+1 (2), tada (1)" {
+		t.Fatalf("plaintext summary = %q", got)
+	}
+	if got := reactionSummary(reactions, false); got != "👍 2  🎉 1" {
+		t.Fatalf("rich summary = %q", got)
+	}
+}
@@ -0,0 +1,525 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// relationAPI defines the interface for issue relation operations
+type relationAPI interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) (*api.IssueRelation, error)
+	DeleteIssueRelation(ctx context.Context, id string) error
+	GetBlockedIssues(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.Issues, error)
+}
+
+// Injection points for testing
+var newRelationAPIClient = func(authHeader string) relationAPI { return api.NewClient(authHeader) }
+var getRelationAuthHeader = auth.GetAuthHeader
+
+// Dependency kinds, as read from the issue being shown
+const (
+	dependencyBlockedBy    = "Blocked by"
+	dependencyBlocks       = "Blocks"
+	dependencyDuplicateOf  = "Duplicate of"
+	dependencyDuplicatedBy = "Duplicated by"
+	dependencyRelated      = "Related to"
+)
+
+// dependencyOrder is the order dependency kinds are listed in
+var dependencyOrder = []string{dependencyBlockedBy, dependencyBlocks, dependencyDuplicateOf, dependencyDuplicatedBy, dependencyRelated}
+
+// relationFlags maps the relate/unrelate flag names to dependency kinds
+var relationFlags = []struct {
+	flag string
+	kind string
+}{
+	{"blocks", dependencyBlocks},
+	{"blocked-by", dependencyBlockedBy},
+	{"related", dependencyRelated},
+	{"duplicate-of", dependencyDuplicateOf},
+}
+
+// issueDependency is one relation as seen from a single issue
+type issueDependency struct {
+	Kind       string
+	RelationID string
+	Issue      *api.Issue
+}
+
+var issueRelateCmd = &cobra.Command{
+	Use:   "relate ISSUE",
+	Short: "Relate an issue to other issues",
+	Long: `Mark an issue as blocking, blocked by, related to, or a duplicate of other issues.
+
+Examples:
+  linctl issue relate ENG-1 --blocks ENG-2
+  linctl issue relate ENG-1 --blocked-by ENG-3,ENG-4
+  linctl issue relate ENG-1 --related ENG-5
+  linctl issue relate ENG-6 --duplicate-of ENG-1`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := relationClient(plaintext, jsonOut)
+		runIssueRelate(cmd, client, args[0], plaintext, jsonOut)
+	},
+}
+
+var issueUnrelateCmd = &cobra.Command{
+	Use:   "unrelate ISSUE OTHER",
+	Short: "Remove relations between two issues",
+	Long: `Remove the relations between ISSUE and OTHER. Every relation between the two
+is removed unless --type narrows it down.
+
+Examples:
+  linctl issue unrelate ENG-1 ENG-2
+  linctl issue unrelate ENG-1 ENG-2 --type blocks`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		client := relationClient(plaintext, jsonOut)
+		runIssueUnrelate(cmd, client, args[0], args[1], plaintext, jsonOut)
+	},
+}
+
+var issueBlockedCmd = &cobra.Command{
+	Use:   "blocked",
+	Short: "List issues with open blockers",
+	Long: `List open issues that are blocked by at least one issue that is not yet
+completed or canceled.
+
+Examples:
+  linctl issue blocked --team ENG
+  linctl issue blocked --assignee me`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		columns, customColumns := selectColumns(cmd, blockedIssueColumns, plaintext, jsonOut)
+
+		client := relationClient(plaintext, jsonOut)
+		runIssueBlocked(cmd, client, columns, customColumns, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueRelateCmd)
+	issueCmd.AddCommand(issueUnrelateCmd)
+	issueCmd.AddCommand(issueBlockedCmd)
+
+	issueRelateCmd.Flags().StringSlice("blocks", nil, "Issues this issue blocks")
+	issueRelateCmd.Flags().StringSlice("blocked-by", nil, "Issues that block this issue")
+	issueRelateCmd.Flags().StringSlice("related", nil, "Issues related to this issue")
+	issueRelateCmd.Flags().StringSlice("duplicate-of", nil, "Issue this issue duplicates")
+
+	issueUnrelateCmd.Flags().String("type", "", "Only remove this relation: blocks, blocked-by, related, duplicate-of")
+
+	issueBlockedCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueBlockedCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
+	issueBlockedCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to return")
+	addPaginationFlags(issueBlockedCmd)
+	addColumnFlags(issueBlockedCmd, blockedIssueColumns)
+}
+
+// relationClient authenticates and returns a relation API client, exiting on failure
+func relationClient(plaintext, jsonOut bool) relationAPI {
+	authHeader, err := getRelationAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newRelationAPIClient(authHeader)
+}
+
+func runIssueRelate(cmd *cobra.Command, client relationAPI, ref string, plaintext, jsonOut bool) {
+	type pending struct {
+		kind  string
+		other string
+	}
+	var requested []pending
+	for _, rf := range relationFlags {
+		others, _ := cmd.Flags().GetStringSlice(rf.flag)
+		for _, other := range others {
+			if other = strings.TrimSpace(other); other != "" {
+				requested = append(requested, pending{rf.kind, other})
+			}
+		}
+	}
+	if len(requested) == 0 {
+		output.Error("Specify at least one of --blocks, --blocked-by, --related or --duplicate-of", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	issue := fetchRelationIssue(ctx, client, ref, plaintext, jsonOut)
+
+	startDryRunBatch()
+	var created []api.IssueRelation
+	for _, req := range requested {
+		other := fetchRelationIssue(ctx, client, req.other, plaintext, jsonOut)
+		if other.ID == issue.ID {
+			output.Error(fmt.Sprintf("%s cannot be related to itself", issue.Identifier), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+
+		from, to, relationType := relationDirection(issue, other, req.kind)
+		relation, err := client.CreateIssueRelation(ctx, from.ID, to.ID, relationType)
+		if errors.Is(err, api.ErrDryRun) {
+			continue
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to relate %s to %s: %v", issue.Identifier, other.Identifier, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if relation.Issue == nil {
+			relation.Issue = from
+		}
+		if relation.RelatedIssue == nil {
+			relation.RelatedIssue = to
+		}
+		created = append(created, *relation)
+	}
+
+	if finishDryRun(plaintext, jsonOut) {
+		return
+	}
+	if jsonOut {
+		output.JSON(created)
+		return
+	}
+	for _, relation := range created {
+		output.Success(relationSentence(relation), plaintext, jsonOut)
+	}
+}
+
+func runIssueUnrelate(cmd *cobra.Command, client relationAPI, ref, otherRef string, plaintext, jsonOut bool) {
+	kind := ""
+	if relationType, _ := cmd.Flags().GetString("type"); relationType != "" {
+		for _, rf := range relationFlags {
+			if strings.EqualFold(rf.flag, relationType) {
+				kind = rf.kind
+			}
+		}
+		if kind == "" {
+			output.Error(fmt.Sprintf("Invalid relation type %q (expected blocks, blocked-by, related or duplicate-of)", relationType), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+	}
+
+	ctx := context.Background()
+	issue := fetchRelationIssue(ctx, client, ref, plaintext, jsonOut)
+
+	var matches []issueDependency
+	for _, dep := range issueDependencies(issue) {
+		if !strings.EqualFold(dep.Issue.Identifier, otherRef) && dep.Issue.ID != otherRef {
+			continue
+		}
+		// Removing a duplicate relation works from either side
+		if kind != "" && dep.Kind != kind && !(kind == dependencyDuplicateOf && dep.Kind == dependencyDuplicatedBy) {
+			continue
+		}
+		matches = append(matches, dep)
+	}
+	if len(matches) == 0 {
+		output.Error(fmt.Sprintf("No relation found between %s and %s", issue.Identifier, otherRef), plaintext, jsonOut)
+		os.Exit(exitNotFound)
+	}
+
+	startDryRunBatch()
+	deleted := make([]string, 0, len(matches))
+	for _, dep := range matches {
+		if err := client.DeleteIssueRelation(ctx, dep.RelationID); err != nil && !errors.Is(err, api.ErrDryRun) {
+			output.Error(fmt.Sprintf("Failed to remove relation: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		deleted = append(deleted, dep.RelationID)
+	}
+
+	if finishDryRun(plaintext, jsonOut) {
+		return
+	}
+	if jsonOut {
+		output.JSON(map[string]interface{}{"success": true, "relationIds": deleted})
+		return
+	}
+	for _, dep := range matches {
+		output.Success(fmt.Sprintf("Removed relation: %s %s %s", issue.Identifier, strings.ToLower(dep.Kind), dep.Issue.Identifier), plaintext, jsonOut)
+	}
+}
+
+func runIssueBlocked(cmd *cobra.Command, client relationAPI, columns []tableColumn[api.Issue], customColumns, plaintext, jsonOut bool) {
+	filter := map[string]interface{}{
+		"state": map[string]interface{}{"type": map[string]interface{}{"nin": []string{"completed", "canceled"}}},
+	}
+	if team, _ := cmd.Flags().GetString("team"); team != "" {
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+	}
+	if assignee, _ := cmd.Flags().GetString("assignee"); assignee == "me" {
+		filter["assignee"] = map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}
+	} else if assignee != "" {
+		filter["assignee"] = map[string]interface{}{"email": map[string]interface{}{"eq": assignee}}
+	}
+
+	pageSize, limit := paginationOptions(cmd)
+	nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+		func(ctx context.Context, first int, after string) ([]api.Issue, api.PageInfo, error) {
+			// Issues whose blockers are all done are not stuck. A page may hold
+			// only those, so keep reading until one has a blocked issue, since
+			// an empty page ends the listing.
+			for {
+				page, err := client.GetBlockedIssues(ctx, filter, first, after)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				var blocked []api.Issue
+				for _, issue := range page.Nodes {
+					if len(openBlockers(&issue)) > 0 {
+						blocked = append(blocked, issue)
+					}
+				}
+				info := page.PageInfo
+				if len(blocked) > 0 || !info.HasNextPage || info.EndCursor == "" || info.EndCursor == after {
+					return blocked, info, nil
+				}
+				after = info.EndCursor
+			}
+		})
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch blocked issues: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if streamed {
+		return
+	}
+
+	if len(nodes) == 0 {
+		output.Info("No blocked issues found", plaintext, jsonOut)
+		return
+	}
+
+	if jsonOut {
+		output.JSON(nodes)
+		return
+	}
+
+	if plaintext && customColumns {
+		output.Table(buildTable(columns, nodes, false), true, false)
+		return
+	}
+
+	if plaintext {
+		fmt.Println("# Blocked Issues")
+		for _, issue := range nodes {
+			fmt.Printf("## %s\n", issue.Title)
+			fmt.Printf("- **ID**: %s\n", issue.Identifier)
+			if issue.State != nil {
+				fmt.Printf("- **State**: %s\n", issue.State.Name)
+			}
+			if issue.Assignee != nil {
+				fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
+			} else {
+				fmt.Printf("- **Assignee**: Unassigned\n")
+			}
+			for _, blocker := range openBlockers(&issue) {
+				fmt.Printf("- **Blocked by**: %s - %s%s\n", blocker.Identifier, blocker.Title, stateSuffix(blocker))
+			}
+			fmt.Printf("- **URL**: %s\n", issue.URL)
+			fmt.Println()
+		}
+		fmt.Printf("\nTotal: %d blocked issues\n", len(nodes))
+		return
+	}
+
+	output.Table(buildTable(columns, nodes, true), false, false)
+
+	fmt.Printf("\n%s %d blocked issues\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		len(nodes))
+
+	printMoreResultsHint(pageInfo)
+}
+
+// blockedIssueColumns are the issue columns plus the open blockers
+var blockedIssueColumns = columnSet[api.Issue]{
+	columns: append(append([]tableColumn[api.Issue]{}, issueColumns.columns...), tableColumn[api.Issue]{
+		Name: "blockers", Header: "Blocked By", Flex: true, Value: func(i api.Issue) string {
+			blockers := openBlockers(&i)
+			ids := make([]string, len(blockers))
+			for j, blocker := range blockers {
+				ids[j] = blocker.Identifier
+			}
+			return strings.Join(ids, ", ")
+		},
+		Color: func(api.Issue) *color.Color { return color.New(color.FgRed) },
+	}),
+	defaults: []string{"identifier", "title", "state", "assignee", "team", "blockers"},
+	wide:     []string{"identifier", "title", "state", "priority", "assignee", "team", "project", "cycle", "due", "blockers", "url"},
+}
+
+// fetchRelationIssue loads an issue by ID or identifier, exiting on failure
+func fetchRelationIssue(ctx context.Context, client relationAPI, ref string, plaintext, jsonOut bool) *api.Issue {
+	issue, err := client.GetIssue(ctx, ref)
+	if err == nil && issue.ID == "" {
+		err = fmt.Errorf("issue %q %w", ref, api.ErrNotFound)
+	}
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch issue %s: %v", ref, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return issue
+}
+
+// relationDirection maps a dependency kind to the relation Linear stores:
+// "from <type> to". Blocked-by is a blocks relation the other way round.
+func relationDirection(issue, other *api.Issue, kind string) (from, to *api.Issue, relationType string) {
+	switch kind {
+	case dependencyBlocks:
+		return issue, other, "blocks"
+	case dependencyBlockedBy:
+		return other, issue, "blocks"
+	case dependencyDuplicateOf:
+		return issue, other, "duplicate"
+	default:
+		return issue, other, "related"
+	}
+}
+
+// relationSentence describes a relation, e.g. "ENG-1 blocks ENG-2"
+func relationSentence(relation api.IssueRelation) string {
+	from, to := "?", "?"
+	if relation.Issue != nil {
+		from = relation.Issue.Identifier
+	}
+	if relation.RelatedIssue != nil {
+		to = relation.RelatedIssue.Identifier
+	}
+	switch relation.Type {
+	case "blocks":
+		return fmt.Sprintf("%s blocks %s", from, to)
+	case "duplicate":
+		return fmt.Sprintf("%s is a duplicate of %s", from, to)
+	default:
+		return fmt.Sprintf("%s is related to %s", from, to)
+	}
+}
+
+// issueDependencies flattens an issue's relations and inverse relations into
+// dependencies read from the issue's side, grouped in dependencyOrder
+func issueDependencies(issue *api.Issue) []issueDependency {
+	grouped := make(map[string][]issueDependency)
+	if issue.Relations != nil {
+		for _, relation := range issue.Relations.Nodes {
+			if relation.RelatedIssue == nil {
+				continue
+			}
+			kind := dependencyRelated
+			switch relation.Type {
+			case "blocks":
+				kind = dependencyBlocks
+			case "blocked":
+				kind = dependencyBlockedBy
+			case "duplicate":
+				kind = dependencyDuplicateOf
+			}
+			grouped[kind] = append(grouped[kind], issueDependency{kind, relation.ID, relation.RelatedIssue})
+		}
+	}
+	if issue.InverseRelations != nil {
+		for _, relation := range issue.InverseRelations.Nodes {
+			if relation.Issue == nil {
+				continue
+			}
+			kind := dependencyRelated
+			switch relation.Type {
+			case "blocks":
+				kind = dependencyBlockedBy
+			case "duplicate":
+				kind = dependencyDuplicatedBy
+			}
+			grouped[kind] = append(grouped[kind], issueDependency{kind, relation.ID, relation.Issue})
+		}
+	}
+
+	var deps []issueDependency
+	for _, kind := range dependencyOrder {
+		deps = append(deps, grouped[kind]...)
+	}
+	return deps
+}
+
+// openBlockers returns the issues blocking issue that are not yet done
+func openBlockers(issue *api.Issue) []*api.Issue {
+	var blockers []*api.Issue
+	for _, dep := range issueDependencies(issue) {
+		if dep.Kind == dependencyBlockedBy && isOpenIssue(dep.Issue) {
+			blockers = append(blockers, dep.Issue)
+		}
+	}
+	return blockers
+}
+
+// isOpenIssue reports whether an issue is neither completed nor canceled
+func isOpenIssue(issue *api.Issue) bool {
+	return issue.State == nil || (issue.State.Type != "completed" && issue.State.Type != "canceled")
+}
+
+// stateSuffix formats an issue's state as " [State]" for plaintext listings
+func stateSuffix(issue *api.Issue) string {
+	if issue.State == nil {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", issue.State.Name)
+}
+
+// printIssueDependencies renders the dependency section of issue get
+func printIssueDependencies(issue *api.Issue, plaintext bool) {
+	deps := issueDependencies(issue)
+	if len(deps) == 0 {
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("\n## Dependencies\n")
+		for _, dep := range deps {
+			fmt.Printf("- %s: %s - %s%s\n", dep.Kind, dep.Issue.Identifier, dep.Issue.Title, stateSuffix(dep.Issue))
+		}
+		return
+	}
+
+	fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Dependencies:"))
+	for _, dep := range deps {
+		icon := color.New(color.FgWhite, color.Faint).Sprint("↔")
+		switch {
+		case dep.Kind == dependencyBlockedBy && isOpenIssue(dep.Issue):
+			icon = color.New(color.FgRed).Sprint("⛔")
+		case dep.Kind == dependencyBlockedBy:
+			icon = color.New(color.FgGreen).Sprint("✓")
+		case dep.Kind == dependencyBlocks:
+			icon = color.New(color.FgYellow).Sprint("→")
+		}
+		state := ""
+		if dep.Issue.State != nil {
+			state = color.New(color.FgWhite, color.Faint).Sprintf(" (%s)", dep.Issue.State.Name)
+		}
+		fmt.Printf("  %s %-13s %s %s%s\n",
+			icon,
+			dep.Kind,
+			color.New(color.FgCyan).Sprint(dep.Issue.Identifier),
+			dep.Issue.Title,
+			state)
+	}
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type mockRelationClient struct {
+	issues  map[string]*api.Issue
+	blocked []api.Issue
+	// blockedPages, when set, is served one page per cursor instead of blocked
+	blockedPages [][]api.Issue
+	cursors      []string
+	created      [][3]string
+	deleted      []string
+}
+
+func (m *mockRelationClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	if issue, ok := m.issues[strings.ToUpper(id)]; ok {
+		return issue, nil
+	}
+	return &api.Issue{}, nil
+}
+
+func (m *mockRelationClient) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) (*api.IssueRelation, error) {
+	m.created = append(m.created, [3]string{issueID, relatedIssueID, relationType})
+	return &api.IssueRelation{ID: "rel-new", Type: relationType}, nil
+}
+
+func (m *mockRelationClient) DeleteIssueRelation(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *mockRelationClient) GetBlockedIssues(ctx context.Context, filter map[string]interface{}, first int, after string) (*api.Issues, error) {
+	if m.blockedPages == nil {
+		return &api.Issues{Nodes: m.blocked}, nil
+	}
+	m.cursors = append(m.cursors, after)
+	index := 0
+	if after != "" {
+		index, _ = strconv.Atoi(strings.TrimPrefix(after, "page-"))
+	}
+	next := index + 1
+	return &api.Issues{
+		Nodes:    m.blockedPages[index],
+		PageInfo: api.PageInfo{HasNextPage: next < len(m.blockedPages), EndCursor: fmt.Sprintf("page-%d", next)},
+	}, nil
+}
+
+func withInjectedRelationClient(t *testing.T, mc *mockRelationClient, fn func()) {
+	t.Helper()
+	oldNew := newRelationAPIClient
+	oldAuth := getRelationAuthHeader
+	newRelationAPIClient = func(_ string) relationAPI { return mc }
+	getRelationAuthHeader = func() (string, error) { return "Bearer test", nil }
+	defer func() { newRelationAPIClient = oldNew; getRelationAuthHeader = oldAuth }()
+	fn()
+}
+
+func relationIssue(identifier, stateType string) *api.Issue {
+	return &api.Issue{
+		ID:         "id-" + strings.ToLower(identifier),
+		Identifier: identifier,
+		Title:      "Issue " + identifier,
+		State:      &api.State{Name: stateType, Type: stateType},
+	}
+}
+
+func sampleRelatedIssue() *api.Issue {
+	issue := relationIssue("ENG-1", "started")
+	issue.Relations = &api.IssueRelations{Nodes: []api.IssueRelation{
+		{ID: "rel-blocks", Type: "blocks", RelatedIssue: relationIssue("ENG-2", "unstarted")},
+		{ID: "rel-related", Type: "related", RelatedIssue: relationIssue("ENG-5", "backlog")},
+	}}
+	issue.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{
+		{ID: "rel-open", Type: "blocks", Issue: relationIssue("ENG-3", "started")},
+		{ID: "rel-done", Type: "blocks", Issue: relationIssue("ENG-4", "completed")},
+		{ID: "rel-dup", Type: "duplicate", Issue: relationIssue("ENG-6", "canceled")},
+	}}
+	return issue
+}
+
+func TestIssueDependencies(t *testing.T) {
+	var got []string
+	for _, dep := range issueDependencies(sampleRelatedIssue()) {
+		got = append(got, dep.Kind+" "+dep.Issue.Identifier)
+	}
+	want := []string{"Blocked by ENG-3", "Blocked by ENG-4", "Blocks ENG-2", "Duplicated by ENG-6", "Related to ENG-5"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("dependencies = %v, want %v", got, want)
+	}
+
+	blockers := openBlockers(sampleRelatedIssue())
+	if len(blockers) != 1 || blockers[0].Identifier != "ENG-3" {
+		t.Fatalf("open blockers = %v, want only ENG-3", blockers)
+	}
+}
+
+func newRelateCommand() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringSlice("blocks", nil, "")
+	cmd.Flags().StringSlice("blocked-by", nil, "")
+	cmd.Flags().StringSlice("related", nil, "")
+	cmd.Flags().StringSlice("duplicate-of", nil, "")
+	return cmd
+}
+
+func TestIssueRelateDirections(t *testing.T) {
+	mc := &mockRelationClient{issues: map[string]*api.Issue{
+		"ENG-1": relationIssue("ENG-1", "started"),
+		"ENG-2": relationIssue("ENG-2", "started"),
+		"ENG-3": relationIssue("ENG-3", "started"),
+		"ENG-4": relationIssue("ENG-4", "started"),
+	}}
+	viper.Set("plaintext", true)
+	viper.Set("json", false)
+	defer viper.Set("plaintext", false)
+
+	cmd := newRelateCommand()
+	_ = cmd.Flags().Set("blocks", "ENG-2")
+	_ = cmd.Flags().Set("blocked-by", "eng-3")
+	_ = cmd.Flags().Set("duplicate-of", "ENG-4")
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueRelate(cmd, mc, "ENG-1", true, false)
+	})
+
+	want := [][3]string{
+		{"id-eng-1", "id-eng-2", "blocks"},
+		{"id-eng-3", "id-eng-1", "blocks"},
+		{"id-eng-1", "id-eng-4", "duplicate"},
+	}
+	if !reflect.DeepEqual(mc.created, want) {
+		t.Fatalf("created = %v, want %v", mc.created, want)
+	}
+	if !contains(out, "ENG-3 blocks ENG-1") || !contains(out, "ENG-1 is a duplicate of ENG-4") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestIssueUnrelate(t *testing.T) {
+	tests := []struct {
+		name  string
+		other string
+		kind  string
+		want  []string
+	}{
+		{"all relations to an issue", "eng-3", "", []string{"rel-open"}},
+		{"duplicate from the other side", "ENG-6", "duplicate-of", []string{"rel-dup"}},
+	}
+
+	viper.Set("plaintext", true)
+	viper.Set("json", false)
+	defer viper.Set("plaintext", false)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &mockRelationClient{issues: map[string]*api.Issue{"ENG-1": sampleRelatedIssue()}}
+			cmd := &cobra.Command{}
+			cmd.Flags().String("type", "", "")
+			_ = cmd.Flags().Set("type", tt.kind)
+
+			captureMilestoneStdout(t, func() {
+				runIssueUnrelate(cmd, mc, "ENG-1", tt.other, true, false)
+			})
+			if !reflect.DeepEqual(mc.deleted, tt.want) {
+				t.Fatalf("deleted = %v, want %v", mc.deleted, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueBlockedSkipsDoneBlockers(t *testing.T) {
+	stuck := sampleRelatedIssue()
+	unblocked := relationIssue("ENG-7", "started")
+	unblocked.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{
+		{ID: "rel-x", Type: "blocks", Issue: relationIssue("ENG-8", "completed")},
+	}}
+	mc := &mockRelationClient{blocked: []api.Issue{*stuck, *unblocked}}
+
+	viper.Set("plaintext", true)
+	viper.Set("json", false)
+	defer viper.Set("plaintext", false)
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("team", "", "")
+	cmd.Flags().String("assignee", "", "")
+	cmd.Flags().Int("limit", 50, "")
+	addPaginationFlags(cmd)
+
+	withInjectedRelationClient(t, mc, func() {
+		out := captureMilestoneStdout(t, func() {
+			runIssueBlocked(cmd, relationClient(true, false), nil, false, true, false)
+		})
+		if !contains(out, "Blocked by**: ENG-3") || contains(out, "ENG-7") || contains(out, "ENG-4") {
+			t.Fatalf("unexpected output: %q", out)
+		}
+	})
+}
+
+func TestIssueBlockedReadsPastPagesOfDoneBlockers(t *testing.T) {
+	unblocked := relationIssue("ENG-7", "started")
+	unblocked.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{
+		{ID: "rel-x", Type: "blocks", Issue: relationIssue("ENG-8", "completed")},
+	}}
+	mc := &mockRelationClient{blockedPages: [][]api.Issue{{*unblocked}, {*sampleRelatedIssue()}}}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("team", "", "")
+	cmd.Flags().String("assignee", "", "")
+	cmd.Flags().Int("limit", 1, "")
+	addPaginationFlags(cmd)
+
+	out := captureMilestoneStdout(t, func() {
+		runIssueBlocked(cmd, mc, nil, false, false, true)
+	})
+
+	if !reflect.DeepEqual(mc.cursors, []string{"", "page-1"}) {
+		t.Fatalf("cursors = %v, want both pages read", mc.cursors)
+	}
+	if !contains(out, `"identifier": "ENG-1"`) || contains(out, "ENG-7") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
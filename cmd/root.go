@@ -5,6 +5,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -14,6 +17,11 @@ var (
 	cfgFile   string
 	plaintext bool
 	jsonOut   bool
+	profile   string
+	format    string
+	fields    []string
+	outputFmt string
+	dryRun    bool
 )
 
 // version is set at build time via -ldflags
@@ -76,7 +84,7 @@ var rootCmd = &cobra.Command{
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(exitUsage)
 	}
 }
 
@@ -92,6 +100,11 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.linctl.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "auth profile to use (default is $LINCTL_PROFILE or the current profile)")
+	rootCmd.PersistentFlags().StringVar(&format, "format", "", "format output with a Go template, e.g. '{{.Identifier}} {{.State.Name}}'")
+	rootCmd.PersistentFlags().StringSliceVar(&fields, "fields", nil, "limit JSON output to these fields, e.g. id,identifier,state.name")
+	rootCmd.PersistentFlags().StringVar(&outputFmt, "output", "", "output format: table, plaintext, json, csv, tsv, yaml or ndjson")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "print mutations with their resolved variables instead of sending them")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
@@ -116,6 +129,20 @@ func initConfig() {
 
 	viper.AutomaticEnv() // read in environment variables that match
 
+	// Select the auth profile; LINCTL_PROFILE is resolved by the auth package
+	auth.SetProfile(profile)
+
+	// --format, --fields and --output render the JSON form of command output
+	if err := configureOutput(); err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	// --dry-run intercepts mutations; lookups still run to resolve IDs
+	if dryRun {
+		api.DryRun = previewMutation
+	}
+
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
 		if !plaintext && !jsonOut {
@@ -123,3 +150,25 @@ func initConfig() {
 		}
 	}
 }
+
+// configureOutput applies the --output, --format and --fields flags
+func configureOutput() error {
+	if format != "" && outputFmt != "" {
+		return fmt.Errorf("--format cannot be combined with --output")
+	}
+	if err := output.SetMode(outputFmt); err != nil {
+		return err
+	}
+	if err := output.SetFormat(format); err != nil {
+		return err
+	}
+	output.SetFields(fields)
+
+	switch {
+	case outputFmt == output.ModePlaintext:
+		viper.Set("plaintext", true)
+	case outputFmt == output.ModeJSON, output.Formatting():
+		viper.Set("json", true)
+	}
+	return nil
+}
@@ -34,19 +34,20 @@ var teamListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		columns, customColumns := selectColumns(cmd, teamColumns, plaintext, jsonOut)
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
-		// Get limit
-		limit, _ := cmd.Flags().GetInt("limit")
+		// Get pagination options
+		pageSize, limit := paginationOptions(cmd)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
@@ -62,27 +63,37 @@ var teamListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
 		// Get teams
-		teams, err := client.GetTeams(context.Background(), limit, "", orderBy)
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.Team, api.PageInfo, error) {
+				page, err := client.GetTeams(ctx, first, after, orderBy)
+				if err != nil {
+					return nil, api.PageInfo{}, err
+				}
+				return page.Nodes, page.PageInfo, nil
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		if streamed {
+			return
 		}
+		teams := &api.Teams{Nodes: nodes, PageInfo: pageInfo}
 
 		// Handle output
 		if jsonOut {
 			output.JSON(teams.Nodes)
+		} else if plaintext && customColumns {
+			output.Table(buildTable(columns, teams.Nodes, false), true, false)
 		} else if plaintext {
 			fmt.Println("Key\tName\tDescription\tPrivate\tIssues")
 			for _, team := range teams.Nodes {
-				description := team.Description
-				if len(description) > 50 {
-					description = description[:47] + "..."
-				}
+				description := truncateString(team.Description, 50)
 				fmt.Printf("%s\t%s\t%s\t%v\t%d\n",
 					team.Key,
 					team.Name,
@@ -92,46 +103,43 @@ var teamListCmd = &cobra.Command{
 				)
 			}
 		} else {
-			// Table output
-			headers := []string{"Key", "Name", "Description", "Private", "Issues"}
-			rows := [][]string{}
-
-			for _, team := range teams.Nodes {
-				description := team.Description
-				if len(description) > 40 {
-					description = description[:37] + "..."
-				}
-
-				privateStr := ""
-				if team.Private {
-					privateStr = color.New(color.FgYellow).Sprint("🔒 Yes")
-				} else {
-					privateStr = color.New(color.FgGreen).Sprint("No")
-				}
-
-				rows = append(rows, []string{
-					color.New(color.FgCyan, color.Bold).Sprint(team.Key),
-					team.Name,
-					description,
-					privateStr,
-					fmt.Sprintf("%d", team.IssueCount),
-				})
-			}
-
-			output.Table(output.TableData{
-				Headers: headers,
-				Rows:    rows,
-			}, plaintext, jsonOut)
+			output.Table(buildTable(columns, teams.Nodes, true), plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
 				fmt.Printf("\n%s %d teams\n",
 					color.New(color.FgGreen).Sprint("✓"),
 					len(teams.Nodes))
+				printMoreResultsHint(teams.PageInfo)
 			}
 		}
 	},
 }
 
+// teamColumns are the table columns available to team list
+var teamColumns = columnSet[api.Team]{
+	columns: []tableColumn[api.Team]{
+		{Name: "id", Header: "ID", Value: func(t api.Team) string { return t.ID }},
+		{Name: "key", Header: "Key", Value: func(t api.Team) string { return t.Key },
+			Color: func(api.Team) *color.Color { return color.New(color.FgCyan, color.Bold) }},
+		{Name: "name", Header: "Name", Value: func(t api.Team) string { return t.Name }},
+		{Name: "description", Header: "Description", Flex: true, Value: func(t api.Team) string { return t.Description }},
+		{Name: "private", Header: "Private", Value: func(t api.Team) string {
+			if t.Private {
+				return "🔒 Yes"
+			}
+			return "No"
+		}, Color: func(t api.Team) *color.Color {
+			if t.Private {
+				return color.New(color.FgYellow)
+			}
+			return color.New(color.FgGreen)
+		}},
+		{Name: "issues", Header: "Issues", Value: func(t api.Team) string { return fmt.Sprintf("%d", t.IssueCount) }},
+	},
+	defaults: []string{"key", "name", "description", "private", "issues"},
+	wide:     []string{"id", "key", "name", "description", "private", "issues"},
+}
+
 var teamGetCmd = &cobra.Command{
 	Use:     "get TEAM-KEY",
 	Aliases: []string{"show"},
@@ -147,7 +155,7 @@ var teamGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -157,7 +165,7 @@ var teamGetCmd = &cobra.Command{
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -211,7 +219,7 @@ var teamMembersCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -221,7 +229,7 @@ var teamMembersCmd = &cobra.Command{
 		members, err := client.GetTeamMembers(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -295,4 +303,6 @@ func init() {
 	// List command flags
 	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	addPaginationFlags(teamListCmd)
+	addColumnFlags(teamListCmd, teamColumns)
 }
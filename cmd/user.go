@@ -34,19 +34,20 @@ var userListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		columns, customColumns := selectColumns(cmd, userColumns, plaintext, jsonOut)
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get filters
-		limit, _ := cmd.Flags().GetInt("limit")
+		pageSize, limit := paginationOptions(cmd)
 		activeOnly, _ := cmd.Flags().GetBool("active")
 
 		// Get sort option
@@ -63,32 +64,51 @@ var userListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitUsage)
 			}
 		}
 
-		// Get users
-		users, err := client.GetUsers(context.Background(), limit, "", orderBy)
+		// Get users. Inactive ones are dropped page by page so --limit and
+		// --output ndjson only see active users; a page may hold none, so keep
+		// reading since an empty page ends the listing.
+		nodes, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+			func(ctx context.Context, first int, after string) ([]api.User, api.PageInfo, error) {
+				for {
+					page, err := client.GetUsers(ctx, first, after, orderBy)
+					if err != nil {
+						return nil, api.PageInfo{}, err
+					}
+					if !activeOnly {
+						return page.Nodes, page.PageInfo, nil
+					}
+					var active []api.User
+					for _, user := range page.Nodes {
+						if user.Active {
+							active = append(active, user)
+						}
+					}
+					info := page.PageInfo
+					if len(active) > 0 || !info.HasNextPage || info.EndCursor == "" || info.EndCursor == after {
+						return active, info, nil
+					}
+					after = info.EndCursor
+				}
+			})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
-
-		// Filter active users if requested
-		filteredUsers := users.Nodes
-		if activeOnly {
-			var activeUsers []api.User
-			for _, user := range users.Nodes {
-				if user.Active {
-					activeUsers = append(activeUsers, user)
-				}
-			}
-			filteredUsers = activeUsers
+		if streamed {
+			return
 		}
+		users := &api.Users{Nodes: nodes, PageInfo: pageInfo}
+		filteredUsers := users.Nodes
 
 		// Handle output
 		if jsonOut {
 			output.JSON(filteredUsers)
+		} else if plaintext && customColumns {
+			output.Table(buildTable(columns, filteredUsers, false), true, false)
 		} else if plaintext {
 			fmt.Println("Name\tEmail\tRole\tActive")
 			for _, user := range filteredUsers {
@@ -104,49 +124,60 @@ var userListCmd = &cobra.Command{
 				)
 			}
 		} else {
-			// Table output
-			headers := []string{"Name", "Email", "Role", "Status"}
-			rows := [][]string{}
-
-			for _, user := range filteredUsers {
-				role := "Member"
-				roleColor := color.New(color.FgWhite)
-				if user.Admin {
-					role = "Admin"
-					roleColor = color.New(color.FgYellow)
-				}
-				if user.IsMe {
-					role = role + " (You)"
-					roleColor = color.New(color.FgCyan, color.Bold)
-				}
-
-				status := color.New(color.FgGreen).Sprint("✓ Active")
-				if !user.Active {
-					status = color.New(color.FgRed).Sprint("✗ Inactive")
-				}
-
-				rows = append(rows, []string{
-					user.Name,
-					color.New(color.FgCyan).Sprint(user.Email),
-					roleColor.Sprint(role),
-					status,
-				})
-			}
-
-			output.Table(output.TableData{
-				Headers: headers,
-				Rows:    rows,
-			}, plaintext, jsonOut)
+			output.Table(buildTable(columns, filteredUsers, true), plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
 				fmt.Printf("\n%s %d users\n",
 					color.New(color.FgGreen).Sprint("✓"),
 					len(filteredUsers))
+				printMoreResultsHint(users.PageInfo)
 			}
 		}
 	},
 }
 
+// userColumns are the table columns available to user list
+var userColumns = columnSet[api.User]{
+	columns: []tableColumn[api.User]{
+		{Name: "id", Header: "ID", Value: func(u api.User) string { return u.ID }},
+		{Name: "name", Header: "Name", Flex: true, Value: func(u api.User) string { return u.Name }},
+		{Name: "email", Header: "Email", Flex: true, Value: func(u api.User) string { return u.Email },
+			Color: func(api.User) *color.Color { return color.New(color.FgCyan) }},
+		{Name: "role", Header: "Role", Value: func(u api.User) string {
+			role := "Member"
+			if u.Admin {
+				role = "Admin"
+			}
+			if u.IsMe {
+				role += " (You)"
+			}
+			return role
+		}, Color: func(u api.User) *color.Color {
+			switch {
+			case u.IsMe:
+				return color.New(color.FgCyan, color.Bold)
+			case u.Admin:
+				return color.New(color.FgYellow)
+			default:
+				return color.New(color.FgWhite)
+			}
+		}},
+		{Name: "status", Header: "Status", Value: func(u api.User) string {
+			if u.Active {
+				return "✓ Active"
+			}
+			return "✗ Inactive"
+		}, Color: func(u api.User) *color.Color {
+			if u.Active {
+				return color.New(color.FgGreen)
+			}
+			return color.New(color.FgRed)
+		}},
+	},
+	defaults: []string{"name", "email", "role", "status"},
+	wide:     []string{"id", "name", "email", "role", "status"},
+}
+
 var userGetCmd = &cobra.Command{
 	Use:     "get EMAIL",
 	Aliases: []string{"show"},
@@ -162,7 +193,7 @@ var userGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -172,7 +203,7 @@ var userGetCmd = &cobra.Command{
 		user, err := client.GetUser(context.Background(), email)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -238,7 +269,7 @@ var userMeCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -248,7 +279,7 @@ var userMeCmd = &cobra.Command{
 		user, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -308,4 +339,6 @@ func init() {
 	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return")
 	userListCmd.Flags().BoolP("active", "a", false, "Show only active users")
 	userListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	addPaginationFlags(userListCmd)
+	addColumnFlags(userListCmd, userColumns)
 }
@@ -0,0 +1,539 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/auth"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// webhookAPI defines the interface for webhook operations
+type webhookAPI interface {
+	GetWebhooks(ctx context.Context, first int, after string) (*api.Webhooks, error)
+	GetWebhook(ctx context.Context, id string) (*api.Webhook, error)
+	CreateWebhook(ctx context.Context, input map[string]interface{}) (*api.Webhook, error)
+	UpdateWebhook(ctx context.Context, id string, input map[string]interface{}) (*api.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetTeam(ctx context.Context, key string) (*api.Team, error)
+}
+
+// Injection points for testing
+var newWebhookAPIClient = func(authHeader string) webhookAPI { return api.NewClient(authHeader) }
+var getWebhookAuthHeader = auth.GetAuthHeader
+
+// webhookResourceTypes are the models a webhook can subscribe to, as Linear names them
+var webhookResourceTypes = []string{
+	"Issue", "Comment", "Attachment", "IssueLabel", "Reaction",
+	"Project", "ProjectUpdate", "Cycle", "Document", "Initiative", "IssueSLA",
+}
+
+var webhookCmd = &cobra.Command{
+	Use:     "webhook",
+	Aliases: []string{"webhooks"},
+	Short:   "Manage webhooks",
+	Long: `List, create, update, and delete the webhooks that push workspace changes
+to your endpoints. Managing webhooks requires workspace admin rights.
+
+Examples:
+  linctl webhook list
+  linctl webhook create https://example.com/linear --resource-types Issue,Comment --team ENG
+  linctl webhook update WEBHOOK-ID --disable
+  linctl webhook get WEBHOOK-ID --show-secret
+  linctl webhook delete WEBHOOK-ID`,
+}
+
+var webhookListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List webhooks",
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		columns, _ := selectColumns(cmd, webhookColumns, plaintext, jsonOut)
+		runWebhookList(cmd, webhookClient(plaintext, jsonOut), columns, plaintext, jsonOut)
+	},
+}
+
+var webhookGetCmd = &cobra.Command{
+	Use:     "get WEBHOOK-ID",
+	Aliases: []string{"show"},
+	Short:   "Show a webhook",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runWebhookGet(cmd, webhookClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var webhookCreateCmd = &cobra.Command{
+	Use:     "create URL",
+	Aliases: []string{"new"},
+	Short:   "Create a webhook",
+	Long: `Create a webhook that sends changes to URL. Without --team the webhook
+receives changes from all public teams.
+
+Resource types: ` + strings.Join(webhookResourceTypes, ", ") + `
+
+Examples:
+  linctl webhook create https://example.com/linear -r Issue,Comment
+  linctl webhook create https://example.com/linear -r Project,ProjectUpdate --team ENG --label "Roadmap sync"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runWebhookCreate(cmd, webhookClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var webhookUpdateCmd = &cobra.Command{
+	Use:     "update WEBHOOK-ID",
+	Aliases: []string{"edit"},
+	Short:   "Update a webhook",
+	Long: `Update a webhook's URL, label, resource types or secret, or enable and
+disable it. --resource-types replaces the current list.
+
+Examples:
+  linctl webhook update WEBHOOK-ID --disable
+  linctl webhook update WEBHOOK-ID --resource-types Issue,Comment,Reaction
+  linctl webhook update WEBHOOK-ID --url https://example.com/v2/linear --enable`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runWebhookUpdate(cmd, webhookClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+var webhookDeleteCmd = &cobra.Command{
+	Use:     "delete WEBHOOK-ID",
+	Aliases: []string{"rm", "remove"},
+	Short:   "Delete a webhook",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runWebhookDelete(cmd, webhookClient(plaintext, jsonOut), args[0], plaintext, jsonOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookListCmd)
+	webhookCmd.AddCommand(webhookGetCmd)
+	webhookCmd.AddCommand(webhookCreateCmd)
+	webhookCmd.AddCommand(webhookUpdateCmd)
+	webhookCmd.AddCommand(webhookDeleteCmd)
+
+	webhookListCmd.Flags().IntP("limit", "l", 50, "Maximum number of webhooks to return")
+	webhookListCmd.Flags().Bool("show-secret", false, "Show signing secrets instead of masking them")
+	addPaginationFlags(webhookListCmd)
+	addColumnFlags(webhookListCmd, webhookColumns)
+
+	webhookGetCmd.Flags().Bool("show-secret", false, "Show the signing secret instead of masking it")
+
+	webhookCreateCmd.Flags().StringSliceP("resource-types", "r", nil, "Resource types to receive (comma-separated, or 'all')")
+	webhookCreateCmd.Flags().String("label", "", "Label to recognise the webhook by")
+	webhookCreateCmd.Flags().StringP("team", "t", "", "Only send changes from this team (default: all public teams)")
+	webhookCreateCmd.Flags().String("secret", "", "Signing secret (default: generated by Linear)")
+	webhookCreateCmd.Flags().Bool("disabled", false, "Create the webhook disabled")
+	_ = webhookCreateCmd.MarkFlagRequired("resource-types")
+
+	webhookUpdateCmd.Flags().String("url", "", "New URL")
+	webhookUpdateCmd.Flags().String("label", "", "New label")
+	webhookUpdateCmd.Flags().StringSliceP("resource-types", "r", nil, "Resource types to receive, replacing the current ones")
+	webhookUpdateCmd.Flags().String("secret", "", "New signing secret")
+	webhookUpdateCmd.Flags().Bool("enable", false, "Enable the webhook")
+	webhookUpdateCmd.Flags().Bool("disable", false, "Disable the webhook")
+
+	webhookDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation prompt")
+}
+
+// webhookClient authenticates and returns a webhook API client, exiting on failure
+func webhookClient(plaintext, jsonOut bool) webhookAPI {
+	authHeader, err := getWebhookAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	return newWebhookAPIClient(authHeader)
+}
+
+// webhookColumns are the table columns available to webhook list
+var webhookColumns = columnSet[api.Webhook]{
+	columns: []tableColumn[api.Webhook]{
+		{Name: "id", Header: "ID", Value: func(w api.Webhook) string { return w.ID }},
+		{Name: "label", Header: "Label", Value: func(w api.Webhook) string { return webhookLabel(w) }},
+		{Name: "url", Header: "URL", Flex: true, Value: func(w api.Webhook) string { return w.URL },
+			Color: func(w api.Webhook) *color.Color { return color.New(color.FgBlue) }},
+		{Name: "resources", Header: "Resources", Flex: true, Value: func(w api.Webhook) string {
+			return strings.Join(w.ResourceTypes, ", ")
+		}},
+		{Name: "team", Header: "Team", Value: func(w api.Webhook) string { return webhookScope(w) }},
+		{Name: "enabled", Header: "Enabled", Value: func(w api.Webhook) string {
+			if w.Enabled {
+				return "yes"
+			}
+			return "no"
+		}, Color: func(w api.Webhook) *color.Color {
+			if w.Enabled {
+				return color.New(color.FgGreen)
+			}
+			return color.New(color.FgRed)
+		}},
+		{Name: "secret", Header: "Secret", Value: func(w api.Webhook) string {
+			if w.Secret == nil {
+				return ""
+			}
+			return *w.Secret
+		}},
+		{Name: "creator", Header: "Creator", Value: func(w api.Webhook) string {
+			if w.Creator == nil {
+				return ""
+			}
+			return w.Creator.Name
+		}},
+		{Name: "created", Header: "Created", Value: func(w api.Webhook) string { return w.CreatedAt.Format("2006-01-02") }},
+	},
+	defaults: []string{"label", "url", "resources", "team", "enabled"},
+	wide:     []string{"id", "label", "url", "resources", "team", "enabled", "creator", "created"},
+}
+
+func runWebhookList(cmd *cobra.Command, client webhookAPI, columns []tableColumn[api.Webhook], plaintext, jsonOut bool) {
+	showSecret, _ := cmd.Flags().GetBool("show-secret")
+	pageSize, limit := paginationOptions(cmd)
+	webhooks, pageInfo, streamed, err := collectPages(context.Background(), pageSize, limit,
+		func(ctx context.Context, first int, after string) ([]api.Webhook, api.PageInfo, error) {
+			page, err := client.GetWebhooks(ctx, first, after)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			if !showSecret {
+				for i := range page.Nodes {
+					maskWebhookSecret(&page.Nodes[i])
+				}
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list webhooks: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if streamed {
+		return
+	}
+
+	if len(webhooks) == 0 {
+		output.Info("No webhooks found", plaintext, jsonOut)
+		return
+	}
+
+	if jsonOut {
+		output.JSON(webhooks)
+		return
+	}
+
+	output.Table(buildTable(columns, webhooks, !plaintext), plaintext, jsonOut)
+	if !plaintext {
+		fmt.Printf("\n%s %d webhooks\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(webhooks))
+		printMoreResultsHint(pageInfo)
+	}
+}
+
+func runWebhookGet(cmd *cobra.Command, client webhookAPI, id string, plaintext, jsonOut bool) {
+	webhook, err := client.GetWebhook(context.Background(), id)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to get webhook: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if showSecret, _ := cmd.Flags().GetBool("show-secret"); !showSecret {
+		maskWebhookSecret(webhook)
+	}
+
+	if jsonOut {
+		output.JSON(webhook)
+		return
+	}
+	printWebhook(webhook, plaintext)
+}
+
+func runWebhookCreate(cmd *cobra.Command, client webhookAPI, rawURL string, plaintext, jsonOut bool) {
+	if err := validateWebhookURL(rawURL); err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	values, _ := cmd.Flags().GetStringSlice("resource-types")
+	resourceTypes, err := parseWebhookResourceTypes(values)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	ctx := context.Background()
+	input := map[string]interface{}{
+		"url":           rawURL,
+		"resourceTypes": resourceTypes,
+	}
+	if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+		team, err := client.GetTeam(ctx, teamKey)
+		if err == nil && team.ID == "" {
+			err = fmt.Errorf("team %q %w", teamKey, api.ErrNotFound)
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		input["teamId"] = team.ID
+	} else {
+		input["allPublicTeams"] = true
+	}
+	if label, _ := cmd.Flags().GetString("label"); label != "" {
+		input["label"] = label
+	}
+	if secret, _ := cmd.Flags().GetString("secret"); secret != "" {
+		input["secret"] = secret
+	}
+	if disabled, _ := cmd.Flags().GetBool("disabled"); disabled {
+		input["enabled"] = false
+	}
+
+	webhook, err := client.CreateWebhook(ctx, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to create webhook: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	// The secret is shown once here, since the receiving end needs it
+	if jsonOut {
+		output.JSON(webhook)
+		return
+	}
+	output.Success(fmt.Sprintf("Created webhook %s (ID: %s)", webhookLabel(*webhook), webhook.ID), plaintext, jsonOut)
+	if webhook.Secret != nil && *webhook.Secret != "" {
+		output.Info(fmt.Sprintf("Signing secret: %s", *webhook.Secret), plaintext, jsonOut)
+	}
+}
+
+func runWebhookUpdate(cmd *cobra.Command, client webhookAPI, id string, plaintext, jsonOut bool) {
+	enable, _ := cmd.Flags().GetBool("enable")
+	disable, _ := cmd.Flags().GetBool("disable")
+	if enable && disable {
+		output.Error("--enable and --disable cannot be used together", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	input := make(map[string]interface{})
+	if cmd.Flags().Changed("url") {
+		rawURL, _ := cmd.Flags().GetString("url")
+		if err := validateWebhookURL(rawURL); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		input["url"] = rawURL
+	}
+	if cmd.Flags().Changed("label") {
+		label, _ := cmd.Flags().GetString("label")
+		input["label"] = label
+	}
+	if cmd.Flags().Changed("resource-types") {
+		values, _ := cmd.Flags().GetStringSlice("resource-types")
+		resourceTypes, err := parseWebhookResourceTypes(values)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitUsage)
+		}
+		input["resourceTypes"] = resourceTypes
+	}
+	if cmd.Flags().Changed("secret") {
+		secret, _ := cmd.Flags().GetString("secret")
+		input["secret"] = secret
+	}
+	if enable || disable {
+		input["enabled"] = enable
+	}
+
+	if len(input) == 0 {
+		output.Error("No updates specified. Use --url, --label, --resource-types, --secret, --enable or --disable.", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+
+	webhook, err := client.UpdateWebhook(context.Background(), id, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to update webhook: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	maskWebhookSecret(webhook)
+
+	if jsonOut {
+		output.JSON(webhook)
+		return
+	}
+	state := "enabled"
+	if !webhook.Enabled {
+		state = "disabled"
+	}
+	output.Success(fmt.Sprintf("Updated webhook %s (%s)", webhookLabel(*webhook), state), plaintext, jsonOut)
+}
+
+func runWebhookDelete(cmd *cobra.Command, client webhookAPI, id string, plaintext, jsonOut bool) {
+	force, _ := cmd.Flags().GetBool("force")
+	ctx := context.Background()
+
+	// Confirmation prompt (unless --force, --json or --dry-run)
+	if !force && !jsonOut && !dryRunEnabled() {
+		webhook, err := client.GetWebhook(ctx, id)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get webhook: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		prompt := fmt.Sprintf("Are you sure you want to delete webhook %s (%s)?", webhookLabel(*webhook), webhook.URL)
+		if !confirmAction(prompt) {
+			fmt.Println("Cancelled.")
+			return
+		}
+	}
+
+	if err := client.DeleteWebhook(ctx, id); err != nil {
+		output.Error(fmt.Sprintf("Failed to delete webhook: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"success": true,
+			"id":      id,
+		})
+	} else if plaintext {
+		fmt.Printf("Deleted webhook %s\n", id)
+	} else {
+		fmt.Printf("%s Deleted webhook\n", color.New(color.FgRed).Sprint("🗑"))
+	}
+}
+
+// printWebhook prints a webhook's details
+func printWebhook(webhook *api.Webhook, plaintext bool) {
+	enabled := "yes"
+	if !webhook.Enabled {
+		enabled = "no"
+	}
+	fields := [][2]string{
+		{"Webhook", webhookLabel(*webhook)},
+		{"ID", webhook.ID},
+		{"URL", webhook.URL},
+		{"Enabled", enabled},
+		{"Resources", strings.Join(webhook.ResourceTypes, ", ")},
+		{"Team", webhookScope(*webhook)},
+	}
+	if webhook.Secret != nil {
+		fields = append(fields, [2]string{"Secret", *webhook.Secret})
+	}
+	if webhook.Creator != nil {
+		fields = append(fields, [2]string{"Creator", webhook.Creator.Name})
+	}
+	fields = append(fields, [2]string{"Created", webhook.CreatedAt.Format("2006-01-02 15:04:05")})
+
+	for _, field := range fields {
+		if plaintext {
+			fmt.Printf("%s: %s\n", field[0], field[1])
+		} else {
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprintf("%s:", field[0]), field[1])
+		}
+	}
+}
+
+// parseWebhookResourceTypes maps resource type names, in any case and
+// singular or plural, to the names Linear expects. "all" selects every type.
+func parseWebhookResourceTypes(values []string) ([]string, error) {
+	var types []string
+	seen := map[string]bool{}
+	add := func(resourceType string) {
+		if !seen[resourceType] {
+			seen[resourceType] = true
+			types = append(types, resourceType)
+		}
+	}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if strings.EqualFold(value, "all") {
+			for _, resourceType := range webhookResourceTypes {
+				add(resourceType)
+			}
+			continue
+		}
+
+		found := false
+		for _, resourceType := range webhookResourceTypes {
+			if strings.EqualFold(value, resourceType) || strings.EqualFold(value, resourceType+"s") {
+				add(resourceType)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown resource type %q (expected one of %s, or all)", value, strings.Join(webhookResourceTypes, ", "))
+		}
+	}
+
+	if len(types) == 0 {
+		return nil, fmt.Errorf("at least one resource type is required (%s, or all)", strings.Join(webhookResourceTypes, ", "))
+	}
+	return types, nil
+}
+
+// validateWebhookURL checks that a webhook URL is an absolute http(s) URL
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("invalid webhook URL '%s': expected an absolute URL such as https://example.com/linear", rawURL)
+	}
+	return nil
+}
+
+// maskWebhookSecret hides all but the last four characters of a webhook's secret
+func maskWebhookSecret(webhook *api.Webhook) {
+	if webhook.Secret == nil || *webhook.Secret == "" {
+		return
+	}
+	secret := *webhook.Secret
+	masked := "****"
+	if len(secret) > 8 {
+		masked += secret[len(secret)-4:]
+	}
+	webhook.Secret = &masked
+}
+
+// webhookLabel returns a webhook's label, or its URL's host when it has none
+func webhookLabel(webhook api.Webhook) string {
+	if webhook.Label != nil && *webhook.Label != "" {
+		return *webhook.Label
+	}
+	if parsed, err := url.Parse(webhook.URL); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+	return webhook.URL
+}
+
+// webhookScope describes which teams a webhook receives changes from
+func webhookScope(webhook api.Webhook) string {
+	if webhook.Team != nil {
+		return webhook.Team.Key
+	}
+	if webhook.AllPublicTeams {
+		return "All public teams"
+	}
+	return ""
+}
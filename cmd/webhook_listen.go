@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/dorkitude/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// maxWebhookBody caps the size of a delivery the listener will read
+const maxWebhookBody = 5 << 20
+
+// webhookEvent is a verified delivery with its data decoded into an API type
+type webhookEvent struct {
+	Delivery    string                 `json:"delivery"`
+	Type        string                 `json:"type"`
+	Action      string                 `json:"action"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	URL         string                 `json:"url,omitempty"`
+	Data        interface{}            `json:"data"`
+	UpdatedFrom map[string]interface{} `json:"updatedFrom,omitempty"`
+}
+
+// Injection point for testing
+var runWebhookCommand = func(command string, event []byte, env []string) error {
+	c := exec.Command("sh", "-c", command)
+	c.Stdin = bytes.NewReader(event)
+	c.Stdout, c.Stderr = os.Stdout, os.Stderr
+	c.Env = append(os.Environ(), env...)
+	return c.Run()
+}
+
+var webhookListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Receive webhook deliveries locally",
+	Long: `Start an HTTP server that receives Linear webhook deliveries, verifies
+their Linear-Signature and timestamp, and prints each event or passes it to
+a command.
+
+Point a webhook at the server through a tunnel (ngrok, cloudflared, ...) or
+replay a saved delivery with curl. The signing secret is read from --secret
+or $LINEAR_WEBHOOK_SECRET; 'linctl webhook get ID --show-secret' shows it.
+
+Events are printed as a table, or as JSON with --json or --output ndjson.
+With --exec, the command runs once per event through sh, with the event JSON
+on stdin and LINEAR_EVENT, LINEAR_ACTION and LINEAR_DELIVERY set.
+
+Examples:
+  linctl webhook listen --port 8080 --secret lin_wh_...
+  linctl webhook listen --output ndjson | jq .data.title
+  linctl webhook listen --exec 'jq -r .data.identifier >> touched.txt'
+  linctl webhook listen --tolerance 0 --no-verify   # replaying old fixtures`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		runWebhookListen(cmd, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	webhookCmd.AddCommand(webhookListenCmd)
+
+	webhookListenCmd.Flags().Int("port", 8080, "Port to listen on")
+	webhookListenCmd.Flags().String("host", "localhost", "Address to listen on (use 0.0.0.0 to accept remote connections)")
+	webhookListenCmd.Flags().String("secret", "", "Webhook signing secret (default: $LINEAR_WEBHOOK_SECRET)")
+	webhookListenCmd.Flags().Bool("no-verify", false, "Accept deliveries without checking their signature")
+	webhookListenCmd.Flags().Duration("tolerance", time.Minute, "Maximum age of a delivery's timestamp (0 to skip the check)")
+	webhookListenCmd.Flags().String("exec", "", "Command to run for each event, with the event JSON on stdin")
+}
+
+func runWebhookListen(cmd *cobra.Command, plaintext, jsonOut bool) {
+	secret, _ := cmd.Flags().GetString("secret")
+	if secret == "" {
+		secret = os.Getenv("LINEAR_WEBHOOK_SECRET")
+	}
+	noVerify, _ := cmd.Flags().GetBool("no-verify")
+	if secret == "" && !noVerify {
+		output.Error("A signing secret is required: use --secret or $LINEAR_WEBHOOK_SECRET, or --no-verify to skip verification", plaintext, jsonOut)
+		os.Exit(exitUsage)
+	}
+	if noVerify {
+		secret = ""
+	}
+
+	tolerance, _ := cmd.Flags().GetDuration("tolerance")
+	command, _ := cmd.Flags().GetString("exec")
+	handler := &webhookHandler{
+		secret:    secret,
+		tolerance: tolerance,
+		now:       time.Now,
+		handle: func(event *webhookEvent) error {
+			if command != "" {
+				return execWebhookEvent(command, event)
+			}
+			printWebhookEvent(event, plaintext, jsonOut)
+			return nil
+		},
+	}
+
+	host, _ := cmd.Flags().GetString("host")
+	port, _ := cmd.Flags().GetInt("port")
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to listen on %s: %v", addr, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	// Status goes to stderr so stdout carries only events
+	fmt.Fprintf(os.Stderr, "Listening for Linear webhooks on http://%s (Ctrl+C to stop)\n", listener.Addr())
+	if !plaintext && !jsonOut && command == "" && !output.Streaming() {
+		printWebhookEventHeader()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		output.Error(fmt.Sprintf("Webhook listener failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+}
+
+// webhookHandler verifies and decodes webhook deliveries and passes them to
+// handle one at a time. An empty secret skips signature verification and a
+// zero tolerance skips the timestamp check.
+type webhookHandler struct {
+	secret    string
+	tolerance time.Duration
+	now       func() time.Time
+	handle    func(event *webhookEvent) error
+
+	mu sync.Mutex
+}
+
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read one byte past the cap so an oversized body is refused rather
+	// than truncated, which would fail the signature check
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody+1))
+	if err != nil {
+		h.reject(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(body) > maxWebhookBody {
+		h.reject(w, http.StatusRequestEntityTooLarge, fmt.Errorf("delivery is larger than %d MB", maxWebhookBody>>20))
+		return
+	}
+	if h.secret != "" {
+		if err := api.VerifyWebhookSignature(body, r.Header.Get("Linear-Signature"), h.secret); err != nil {
+			h.reject(w, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	var payload api.WebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		h.reject(w, http.StatusBadRequest, fmt.Errorf("invalid payload: %w", err))
+		return
+	}
+	if h.tolerance > 0 {
+		if err := payload.CheckTimestamp(h.now(), h.tolerance); err != nil {
+			h.reject(w, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	entity, err := payload.Entity()
+	if err != nil {
+		h.reject(w, http.StatusBadRequest, err)
+		return
+	}
+
+	event := &webhookEvent{
+		Delivery:    r.Header.Get("Linear-Delivery"),
+		Type:        payload.Type,
+		Action:      payload.Action,
+		CreatedAt:   payload.CreatedAt,
+		URL:         payload.URL,
+		Data:        entity,
+		UpdatedFrom: payload.UpdatedFrom,
+	}
+
+	h.mu.Lock()
+	err = h.handle(event)
+	h.mu.Unlock()
+	if err != nil {
+		h.reject(w, http.StatusInternalServerError, err)
+		return
+	}
+	fmt.Fprintln(w, "OK")
+}
+
+// reject answers a delivery with an error status and reports it on stderr
+func (h *webhookHandler) reject(w http.ResponseWriter, status int, err error) {
+	fmt.Fprintf(os.Stderr, "Rejected delivery (%d): %v\n", status, err)
+	http.Error(w, err.Error(), status)
+}
+
+// execWebhookEvent runs command with the event JSON on stdin
+func execWebhookEvent(command string, event *webhookEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	env := []string{
+		"LINEAR_EVENT=" + event.Type,
+		"LINEAR_ACTION=" + event.Action,
+		"LINEAR_DELIVERY=" + event.Delivery,
+	}
+	if err := runWebhookCommand(command, data, env); err != nil {
+		return fmt.Errorf("--exec command failed: %w", err)
+	}
+	return nil
+}
+
+// printWebhookEventHeader prints the column headings of the rich event table
+func printWebhookEventHeader() {
+	fmt.Println(color.New(color.Bold).Sprintf("%-8s  %-14s  %-7s  %s", "TIME", "EVENT", "ACTION", "SUBJECT"))
+}
+
+// printWebhookEvent prints one event as a table row, a tab-separated line
+// in plaintext, or JSON (one line per event with --output ndjson)
+func printWebhookEvent(event *webhookEvent, plaintext, jsonOut bool) {
+	if jsonOut || output.Streaming() {
+		output.JSON(event)
+		return
+	}
+
+	subject := webhookEventSubject(event.Data)
+	if plaintext {
+		fmt.Printf("%s\t%s\t%s\t%s\n", event.CreatedAt.Format(time.RFC3339), event.Type, event.Action, subject)
+		return
+	}
+
+	actionColor := color.New(color.FgYellow)
+	switch event.Action {
+	case "create":
+		actionColor = color.New(color.FgGreen)
+	case "remove":
+		actionColor = color.New(color.FgRed)
+	}
+	fmt.Printf("%s  %s  %s  %s\n",
+		color.New(color.FgWhite, color.Faint).Sprintf("%-8s", event.CreatedAt.Local().Format("15:04:05")),
+		color.New(color.FgCyan).Sprintf("%-14s", event.Type),
+		actionColor.Sprintf("%-7s", event.Action),
+		output.Truncate(subject, max(output.TerminalWidth()-37, 20)))
+}
+
+// webhookEventSubject names the entity an event is about
+func webhookEventSubject(data interface{}) string {
+	switch entity := data.(type) {
+	case *api.Issue:
+		return entity.Identifier + " " + entity.Title
+	case *api.Comment:
+		subject := commentExcerpt(entity.Body, 60)
+		if entity.Issue != nil && entity.Issue.Identifier != "" {
+			subject = entity.Issue.Identifier + ": " + subject
+		}
+		return subject
+	case *api.Project:
+		return entity.Name
+	case *api.ProjectUpdate:
+		return commentExcerpt(entity.Body, 60)
+	case *api.Cycle:
+		return cycleTitle(*entity)
+	case *api.Label:
+		return entity.Name
+	case *api.Reaction:
+		return emojiGlyph(entity.Emoji)
+	case *api.Attachment:
+		return entity.Title
+	case map[string]interface{}:
+		if id, ok := entity["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
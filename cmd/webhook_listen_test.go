@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+var listenNow = time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+func webhookDelivery(t *testing.T, eventType, data string) []byte {
+	t.Helper()
+	return []byte(fmt.Sprintf(`{"action":"update","type":%q,"createdAt":"2024-06-01T08:59:59Z","data":%s,"webhookTimestamp":%d}`,
+		eventType, data, listenNow.Add(-time.Second).UnixMilli()))
+}
+
+func newTestWebhookHandler(events *[]*webhookEvent) *webhookHandler {
+	return &webhookHandler{
+		secret:    "secret",
+		tolerance: time.Minute,
+		now:       func() time.Time { return listenNow },
+		handle: func(event *webhookEvent) error {
+			*events = append(*events, event)
+			return nil
+		},
+	}
+}
+
+func postWebhook(handler http.Handler, body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set("Linear-Signature", signature)
+	req.Header.Set("Linear-Delivery", "delivery-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWebhookHandlerDecodesVerifiedDelivery(t *testing.T) {
+	var events []*webhookEvent
+	body := webhookDelivery(t, "Comment", `{"id":"c1","body":"Looks good","issue":{"id":"i1","identifier":"ENG-1"}}`)
+
+	rec := postWebhook(newTestWebhookHandler(&events), body, api.SignWebhook(body, "secret"))
+
+	if rec.Code != http.StatusOK || len(events) != 1 {
+		t.Fatalf("status %d, %d events: %s", rec.Code, len(events), rec.Body)
+	}
+	comment, ok := events[0].Data.(*api.Comment)
+	if !ok || comment.Body != "Looks good" || events[0].Delivery != "delivery-1" || events[0].Action != "update" {
+		t.Fatalf("event = %+v", events[0])
+	}
+	if subject := webhookEventSubject(events[0].Data); subject != "ENG-1: Looks good" {
+		t.Fatalf("subject = %q", subject)
+	}
+}
+
+func TestWebhookHandlerRejectsBadDeliveries(t *testing.T) {
+	var events []*webhookEvent
+	handler := newTestWebhookHandler(&events)
+	body := webhookDelivery(t, "Issue", `{"id":"i1"}`)
+
+	if rec := postWebhook(handler, body, api.SignWebhook(body, "wrong")); rec.Code != http.StatusUnauthorized {
+		t.Errorf("bad signature: status %d", rec.Code)
+	}
+
+	stale := []byte(strings.Replace(string(body), fmt.Sprint(listenNow.Add(-time.Second).UnixMilli()),
+		fmt.Sprint(listenNow.Add(-time.Hour).UnixMilli()), 1))
+	if rec := postWebhook(handler, stale, api.SignWebhook(stale, "secret")); rec.Code != http.StatusUnauthorized {
+		t.Errorf("stale timestamp: status %d", rec.Code)
+	}
+
+	oversized := []byte(`{"data":"` + strings.Repeat("x", maxWebhookBody) + `"}`)
+	if rec := postWebhook(handler, oversized, api.SignWebhook(oversized, "secret")); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized body: status %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: status %d", rec.Code)
+	}
+
+	if len(events) != 0 {
+		t.Fatalf("rejected deliveries were handled: %v", events)
+	}
+}
+
+func TestExecWebhookEventPassesEventOnStdin(t *testing.T) {
+	var gotCommand string
+	var gotEvent map[string]interface{}
+	var gotEnv []string
+	old := runWebhookCommand
+	runWebhookCommand = func(command string, event []byte, env []string) error {
+		gotCommand, gotEnv = command, env
+		return json.Unmarshal(event, &gotEvent)
+	}
+	defer func() { runWebhookCommand = old }()
+
+	event := &webhookEvent{Delivery: "d1", Type: "Issue", Action: "create", Data: &api.Issue{Identifier: "ENG-7"}}
+	if err := execWebhookEvent("./notify.sh", event); err != nil {
+		t.Fatalf("execWebhookEvent: %v", err)
+	}
+
+	if gotCommand != "./notify.sh" || gotEvent["data"].(map[string]interface{})["identifier"] != "ENG-7" {
+		t.Fatalf("command %q got %v", gotCommand, gotEvent)
+	}
+	want := "LINEAR_EVENT=Issue LINEAR_ACTION=create LINEAR_DELIVERY=d1"
+	if strings.Join(gotEnv, " ") != want {
+		t.Fatalf("env = %v, want %s", gotEnv, want)
+	}
+}
+
+func TestPrintWebhookEventPlaintext(t *testing.T) {
+	event := &webhookEvent{
+		Type:      "Issue",
+		Action:    "create",
+		CreatedAt: listenNow,
+		Data:      &api.Issue{Identifier: "ENG-7", Title: "Fix login"},
+	}
+	out := captureMilestoneStdout(t, func() { printWebhookEvent(event, true, false) })
+
+	if out != "2024-06-01T09:00:00Z\tIssue\tcreate\tENG-7 Fix login\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type mockWebhookClient struct {
+	webhooks []api.Webhook
+	created  map[string]interface{}
+	updated  map[string]interface{}
+	deleted  []string
+}
+
+func (m *mockWebhookClient) GetWebhooks(ctx context.Context, first int, after string) (*api.Webhooks, error) {
+	nodes := make([]api.Webhook, len(m.webhooks))
+	copy(nodes, m.webhooks)
+	return &api.Webhooks{Nodes: nodes}, nil
+}
+
+func (m *mockWebhookClient) GetWebhook(ctx context.Context, id string) (*api.Webhook, error) {
+	webhook := m.webhooks[0]
+	return &webhook, nil
+}
+
+func (m *mockWebhookClient) CreateWebhook(ctx context.Context, input map[string]interface{}) (*api.Webhook, error) {
+	m.created = input
+	secret := "lin_wh_generatedsecret"
+	return &api.Webhook{ID: "wh-new", URL: input["url"].(string), Enabled: true, Secret: &secret}, nil
+}
+
+func (m *mockWebhookClient) UpdateWebhook(ctx context.Context, id string, input map[string]interface{}) (*api.Webhook, error) {
+	m.updated = input
+	webhook := m.webhooks[0]
+	webhook.Enabled = input["enabled"] != false
+	return &webhook, nil
+}
+
+func (m *mockWebhookClient) DeleteWebhook(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *mockWebhookClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
+	return &api.Team{ID: "team-" + strings.ToLower(key), Key: key}, nil
+}
+
+func sampleWebhooks() *mockWebhookClient {
+	label := "Deploy bot"
+	secret := "lin_wh_supersecret1234"
+	return &mockWebhookClient{webhooks: []api.Webhook{{
+		ID:            "wh-1",
+		Label:         &label,
+		URL:           "https://example.com/linear",
+		Enabled:       true,
+		Secret:        &secret,
+		ResourceTypes: []string{"Issue", "Comment"},
+		Team:          &api.Team{Key: "ENG"},
+	}}}
+}
+
+func newWebhookCommand(t *testing.T, flags map[string]string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().StringSlice("resource-types", nil, "")
+	cmd.Flags().String("label", "", "")
+	cmd.Flags().String("team", "", "")
+	cmd.Flags().String("secret", "", "")
+	cmd.Flags().String("url", "", "")
+	cmd.Flags().Bool("disabled", false, "")
+	cmd.Flags().Bool("enable", false, "")
+	cmd.Flags().Bool("disable", false, "")
+	cmd.Flags().Bool("show-secret", false, "")
+	cmd.Flags().Bool("force", false, "")
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set --%s: %v", name, err)
+		}
+	}
+	return cmd
+}
+
+func TestParseWebhookResourceTypes(t *testing.T) {
+	types, err := parseWebhookResourceTypes([]string{"issues", "COMMENT", " projectupdate ", "Issue"})
+	if err != nil {
+		t.Fatalf("parseWebhookResourceTypes: %v", err)
+	}
+	if want := []string{"Issue", "Comment", "ProjectUpdate"}; !reflect.DeepEqual(types, want) {
+		t.Fatalf("types = %v, want %v", types, want)
+	}
+
+	if types, _ := parseWebhookResourceTypes([]string{"all"}); !reflect.DeepEqual(types, webhookResourceTypes) {
+		t.Fatalf("all = %v", types)
+	}
+	if _, err := parseWebhookResourceTypes([]string{"Issue", "Widget"}); err == nil || !strings.Contains(err.Error(), `"Widget"`) {
+		t.Fatalf("err = %v, want unknown resource type", err)
+	}
+	if _, err := parseWebhookResourceTypes(nil); err == nil {
+		t.Fatal("no resource types should be an error")
+	}
+}
+
+func TestWebhookCreateScopesToTeam(t *testing.T) {
+	mc := &mockWebhookClient{}
+	cmd := newWebhookCommand(t, map[string]string{"resource-types": "issue,reactions", "team": "ENG", "disabled": "true"})
+	out := captureMilestoneStdout(t, func() {
+		runWebhookCreate(cmd, mc, "https://example.com/hook", true, false)
+	})
+
+	want := map[string]interface{}{
+		"url":           "https://example.com/hook",
+		"resourceTypes": []string{"Issue", "Reaction"},
+		"teamId":        "team-eng",
+		"enabled":       false,
+	}
+	if !reflect.DeepEqual(mc.created, want) {
+		t.Fatalf("created %v, want %v", mc.created, want)
+	}
+	if !contains(out, "Signing secret: lin_wh_generatedsecret") {
+		t.Fatalf("create should show the new secret: %q", out)
+	}
+}
+
+func TestWebhookCreateDefaultsToAllPublicTeams(t *testing.T) {
+	mc := &mockWebhookClient{}
+	captureMilestoneStdout(t, func() {
+		runWebhookCreate(newWebhookCommand(t, map[string]string{"resource-types": "Cycle"}), mc, "https://example.com/hook", true, false)
+	})
+
+	if mc.created["allPublicTeams"] != true || mc.created["teamId"] != nil {
+		t.Fatalf("created %v, want allPublicTeams", mc.created)
+	}
+}
+
+func TestWebhookUpdateDisable(t *testing.T) {
+	mc := sampleWebhooks()
+	out := captureMilestoneStdout(t, func() {
+		runWebhookUpdate(newWebhookCommand(t, map[string]string{"disable": "true"}), mc, "wh-1", true, false)
+	})
+
+	if !reflect.DeepEqual(mc.updated, map[string]interface{}{"enabled": false}) {
+		t.Fatalf("updated %v, want only enabled=false", mc.updated)
+	}
+	if !contains(out, "Updated webhook Deploy bot (disabled)") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestWebhookSecretMaskedUnlessRequested(t *testing.T) {
+	mc := sampleWebhooks()
+	out := captureMilestoneStdout(t, func() {
+		runWebhookGet(newWebhookCommand(t, nil), mc, "wh-1", false, true)
+	})
+	if contains(out, "supersecret") || !contains(out, `"secret": "****1234"`) {
+		t.Fatalf("secret should be masked: %s", out)
+	}
+
+	out = captureMilestoneStdout(t, func() {
+		runWebhookGet(newWebhookCommand(t, map[string]string{"show-secret": "true"}), mc, "wh-1", true, false)
+	})
+	want := "Webhook: Deploy bot\nID: wh-1\nURL: https://example.com/linear\nEnabled: yes\n" +
+		"Resources: Issue, Comment\nTeam: ENG\nSecret: lin_wh_supersecret1234\nCreated: 0001-01-01 00:00:00\n"
+	if out != want {
+		t.Fatalf("output =\n%s\nwant\n%s", out, want)
+	}
+}
+
+func TestWebhookDeleteDeclined(t *testing.T) {
+	var prompts []string
+	withConfirmAnswer(t, false, &prompts)
+
+	mc := sampleWebhooks()
+	captureMilestoneStdout(t, func() {
+		runWebhookDelete(newWebhookCommand(t, nil), mc, "wh-1", true, false)
+	})
+
+	if len(prompts) != 1 || !contains(prompts[0], "Deploy bot (https://example.com/linear)") {
+		t.Fatalf("prompts = %q", prompts)
+	}
+	if len(mc.deleted) != 0 {
+		t.Fatalf("deleted %v after declining", mc.deleted)
+	}
+}
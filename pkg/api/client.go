@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,10 +19,58 @@ const (
 	BaseURL = "https://api.linear.app/graphql"
 )
 
+// Linear rate limit and complexity response headers
+const (
+	headerRequestsLimit       = "X-RateLimit-Requests-Limit"
+	headerRequestsRemaining   = "X-RateLimit-Requests-Remaining"
+	headerRequestsReset       = "X-RateLimit-Requests-Reset"
+	headerComplexityLimit     = "X-RateLimit-Complexity-Limit"
+	headerComplexityRemaining = "X-RateLimit-Complexity-Remaining"
+	headerComplexityReset     = "X-RateLimit-Complexity-Reset"
+	headerComplexity          = "X-Complexity"
+	headerRetryAfter          = "Retry-After"
+)
+
+// RetryPolicy controls how Execute retries rate-limited and failed requests
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// BaseDelay is the initial backoff delay, doubled on each retry
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff delay, including waits for a reset time
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used by clients created with NewClient
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+}
+
+// MutationPreview is a mutation that was intercepted instead of sent
+type MutationPreview struct {
+	Operation string                 `json:"operation"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// DryRun, when set, receives every mutation in place of sending it. Execute
+// then returns DryRun's error, or ErrDryRun when it returns nil. Queries are
+// still sent, so names can be resolved to IDs.
+var DryRun func(MutationPreview) error
+
+// ErrDryRun is returned for mutations intercepted by DryRun
+var ErrDryRun = errors.New("dry run: mutation not sent")
+
 type Client struct {
 	httpClient *http.Client
 	authHeader string
 	baseURL    string
+	retry      RetryPolicy
+
+	mu        sync.Mutex
+	rateLimit *RateLimit
 }
 
 type GraphQLRequest struct {
@@ -31,9 +84,10 @@ type GraphQLResponse struct {
 }
 
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Message    string                  `json:"message"`
+	Locations  []GraphQLErrorLocation  `json:"locations,omitempty"`
+	Path       []interface{}           `json:"path,omitempty"`
+	Extensions *GraphQLErrorExtensions `json:"extensions,omitempty"`
 }
 
 type GraphQLErrorLocation struct {
@@ -54,11 +108,29 @@ func NewClientWithURL(baseURL, authHeader string) *Client {
 		},
 		authHeader: authHeader,
 		baseURL:    baseURL,
+		retry:      DefaultRetryPolicy,
 	}
 }
 
-// Execute performs a GraphQL request
+// SetRetryPolicy replaces the client's retry policy
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retry = policy
+}
+
+// Execute performs a GraphQL request, retrying 429 and 5xx responses with backoff
 func (c *Client) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if DryRun != nil && isMutation(query) {
+		err := DryRun(MutationPreview{
+			Operation: operationName(query),
+			Query:     strings.TrimSpace(dedent(query)),
+			Variables: variables,
+		})
+		if err == nil {
+			err = ErrDryRun
+		}
+		return err
+	}
+
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -69,9 +141,57 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewBuffer(jsonBody))
+	var body []byte
+	for attempt := 0; ; attempt++ {
+		var status int
+		var header http.Header
+		status, header, body, err = c.do(ctx, jsonBody)
+		if err != nil {
+			return err
+		}
+
+		if status == http.StatusOK {
+			break
+		}
+
+		httpErr := newHTTPError(status, body)
+		retryable := isRetryableStatus(status) || errors.Is(httpErr, ErrRateLimited)
+		if !retryable || attempt >= c.retry.MaxRetries {
+			return httpErr
+		}
+
+		if errors.Is(httpErr, ErrRateLimited) {
+			status = http.StatusTooManyRequests
+		}
+
+		if err := sleepContext(ctx, c.retryDelay(attempt, status, header)); err != nil {
+			return fmt.Errorf("request failed: %w", err)
+		}
+	}
+
+	var gqlResp GraphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		return GraphQLErrors(gqlResp.Errors)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// do sends a single request and records the rate limit headers of the response
+func (c *Client) do(ctx context.Context, jsonBody []byte) (int, http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewReader(jsonBody))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -80,50 +200,211 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return 0, nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
+	c.recordRateLimit(resp.Header)
 
+	return resp.StatusCode, resp.Header, body, nil
+}
+
+// newHTTPError builds an HTTPError, decoding any GraphQL errors in the body
+func newHTTPError(status int, body []byte) *HTTPError {
+	httpErr := &HTTPError{StatusCode: status, Body: string(body)}
 	var gqlResp GraphQLResponse
-	if err := json.Unmarshal(body, &gqlResp); err != nil {
-		return fmt.Errorf("failed to parse response: %w", err)
+	if err := json.Unmarshal(body, &gqlResp); err == nil && len(gqlResp.Errors) > 0 {
+		httpErr.Errors = GraphQLErrors(gqlResp.Errors)
 	}
+	return httpErr
+}
 
-	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+// isRetryableStatus reports whether a response status is worth retrying
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// retryDelay computes how long to wait before the next attempt.
+// Rate-limited responses wait for Retry-After or the reported reset time when
+// available; everything else uses jittered exponential backoff.
+func (c *Client) retryDelay(attempt, status int, header http.Header) time.Duration {
+	if status == http.StatusTooManyRequests {
+		if wait, ok := resetWait(header, time.Now()); ok {
+			return c.capDelay(wait)
+		}
 	}
 
-	if result != nil {
-		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", err)
+	backoff := c.retry.BaseDelay << uint(attempt)
+	if backoff <= 0 {
+		return 0
+	}
+	// Full jitter over the upper half keeps concurrent clients from retrying in lockstep
+	half := backoff / 2
+	return c.capDelay(half + time.Duration(rand.Int63n(int64(half)+1)))
+}
+
+func (c *Client) capDelay(d time.Duration) time.Duration {
+	if c.retry.MaxDelay > 0 && d > c.retry.MaxDelay {
+		return c.retry.MaxDelay
+	}
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// resetWait extracts a wait duration from Retry-After or the rate limit reset headers
+func resetWait(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return t.Sub(now), true
 		}
 	}
 
-	return nil
+	for _, name := range []string{headerRequestsReset, headerComplexityReset} {
+		if reset, ok := parseEpochMillis(header.Get(name)); ok {
+			if remaining, err := strconv.Atoi(header.Get(remainingHeaderFor(name))); err == nil && remaining > 0 {
+				continue
+			}
+			return reset.Sub(now), true
+		}
+	}
+
+	return 0, false
+}
+
+func remainingHeaderFor(resetHeader string) string {
+	if resetHeader == headerComplexityReset {
+		return headerComplexityRemaining
+	}
+	return headerRequestsRemaining
+}
+
+func parseEpochMillis(v string) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+	ms, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms), true
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// recordRateLimit stores the rate limit values reported on a response
+func (c *Client) recordRateLimit(header http.Header) {
+	if header.Get(headerRequestsLimit) == "" && header.Get(headerComplexityLimit) == "" {
+		return
+	}
+
+	rl := &RateLimit{}
+	rl.Limit, _ = strconv.Atoi(header.Get(headerRequestsLimit))
+	rl.Remaining, _ = strconv.Atoi(header.Get(headerRequestsRemaining))
+	rl.Reset, _ = parseEpochMillis(header.Get(headerRequestsReset))
+	rl.ComplexityLimit, _ = strconv.Atoi(header.Get(headerComplexityLimit))
+	rl.ComplexityRemaining, _ = strconv.Atoi(header.Get(headerComplexityRemaining))
+	rl.ComplexityReset, _ = parseEpochMillis(header.Get(headerComplexityReset))
+	rl.Complexity, _ = strconv.Atoi(header.Get(headerComplexity))
+
+	c.mu.Lock()
+	c.rateLimit = rl
+	c.mu.Unlock()
 }
 
-// Rate limiting helper
+// GetRateLimit returns the rate limit values seen on the last response.
+// If no request has been made yet, a minimal viewer query is sent first.
 func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
-	// This would query Linear's rate limiting info
-	// For now, we'll return a placeholder
-	return &RateLimit{
-		Limit:     5000,
-		Remaining: 4999,
-		Reset:     time.Now().Add(time.Hour),
-	}, nil
+	if rl := c.LastRateLimit(); rl != nil {
+		return rl, nil
+	}
+
+	if err := c.Execute(ctx, `query RateLimitProbe { viewer { id } }`, nil, nil); err != nil {
+		return nil, err
+	}
+
+	if rl := c.LastRateLimit(); rl != nil {
+		return rl, nil
+	}
+	return nil, fmt.Errorf("rate limit headers not present in API response")
+}
+
+// LastRateLimit returns a copy of the last observed rate limit, or nil
+func (c *Client) LastRateLimit() *RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rateLimit == nil {
+		return nil
+	}
+	rl := *c.rateLimit
+	return &rl
 }
 
 type RateLimit struct {
-	Limit     int       `json:"limit"`
-	Remaining int       `json:"remaining"`
-	Reset     time.Time `json:"reset"`
+	Limit               int       `json:"limit"`
+	Remaining           int       `json:"remaining"`
+	Reset               time.Time `json:"reset"`
+	ComplexityLimit     int       `json:"complexityLimit"`
+	ComplexityRemaining int       `json:"complexityRemaining"`
+	ComplexityReset     time.Time `json:"complexityReset"`
+	// Complexity is the cost of the last query
+	Complexity int `json:"complexity"`
+}
+
+// isMutation reports whether a GraphQL document is a mutation
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// operationName returns the name of a GraphQL operation, e.g. CreateIssue
+func operationName(query string) string {
+	fields := strings.FieldsFunc(strings.TrimSpace(query), func(r rune) bool {
+		return r == ' ' || r == '(' || r == '{' || r == '\n' || r == '\t'
+	})
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[1]
+}
+
+// dedent strips the indentation shared by every non-blank line
+func dedent(s string) string {
+	lines := strings.Split(s, "\n")
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		}
+	}
+	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fastRetry keeps backoff short so retry tests run quickly
+var fastRetry = RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+func TestExecuteRetriesServerErrors(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1"}}})
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	c.SetRetryPolicy(fastRetry)
+
+	var out struct {
+		Viewer User `json:"viewer"`
+	}
+	if err := c.Execute(context.Background(), "query { viewer { id } }", nil, &out); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+	if out.Viewer.ID != "u1" {
+		t.Fatalf("unexpected viewer: %+v", out.Viewer)
+	}
+}
+
+func TestExecuteGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	c.SetRetryPolicy(fastRetry)
+
+	if err := c.Execute(context.Background(), "query { viewer { id } }", nil, nil); err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if calls != fastRetry.MaxRetries+1 {
+		t.Fatalf("expected %d calls, got %d", fastRetry.MaxRetries+1, calls)
+	}
+}
+
+func TestExecuteDoesNotRetryClientErrors(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	c.SetRetryPolicy(fastRetry)
+
+	if err := c.Execute(context.Background(), "query { viewer { id } }", nil, nil); err == nil {
+		t.Fatal("expected error for 400 response")
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestGetRateLimitFromHeaders(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Millisecond)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Requests-Limit", "1500")
+		w.Header().Set("X-RateLimit-Requests-Remaining", "1234")
+		w.Header().Set("X-RateLimit-Requests-Reset", strconv.FormatInt(reset.UnixMilli(), 10))
+		w.Header().Set("X-RateLimit-Complexity-Limit", "250000")
+		w.Header().Set("X-RateLimit-Complexity-Remaining", "249000")
+		w.Header().Set("X-Complexity", "12")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1"}}})
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	rl, err := c.GetRateLimit(context.Background())
+	if err != nil {
+		t.Fatalf("GetRateLimit returned error: %v", err)
+	}
+	if rl.Limit != 1500 || rl.Remaining != 1234 {
+		t.Fatalf("unexpected request limits: %+v", rl)
+	}
+	if rl.ComplexityLimit != 250000 || rl.ComplexityRemaining != 249000 || rl.Complexity != 12 {
+		t.Fatalf("unexpected complexity limits: %+v", rl)
+	}
+	if !rl.Reset.Equal(reset) {
+		t.Fatalf("reset = %v, want %v", rl.Reset, reset)
+	}
+}
+
+func TestResetWait(t *testing.T) {
+	now := time.Now()
+
+	h := http.Header{}
+	h.Set("Retry-After", "7")
+	if d, ok := resetWait(h, now); !ok || d != 7*time.Second {
+		t.Fatalf("Retry-After: got (%v, %v), want (7s, true)", d, ok)
+	}
+
+	h = http.Header{}
+	h.Set("X-RateLimit-Requests-Remaining", "0")
+	h.Set("X-RateLimit-Requests-Reset", strconv.FormatInt(now.Add(5*time.Second).UnixMilli(), 10))
+	if d, ok := resetWait(h, now); !ok || d < 4*time.Second || d > 5*time.Second {
+		t.Fatalf("requests reset: got (%v, %v), want ~5s", d, ok)
+	}
+
+	if _, ok := resetWait(http.Header{}, now); ok {
+		t.Fatal("expected no wait without headers")
+	}
+}
+
+func TestExecuteDryRunInterceptsMutations(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1"}}})
+	}))
+	defer srv.Close()
+
+	var previews []MutationPreview
+	DryRun = func(p MutationPreview) error {
+		previews = append(previews, p)
+		return nil
+	}
+	defer func() { DryRun = nil }()
+
+	c := NewClientWithURL(srv.URL, "test")
+	if _, err := c.GetViewer(context.Background()); err != nil {
+		t.Fatalf("GetViewer: %v", err)
+	}
+	if _, err := c.CreateIssue(context.Background(), map[string]interface{}{"title": "x", "teamId": "t1"}); !errors.Is(err, ErrDryRun) {
+		t.Fatalf("CreateIssue returned %v, want ErrDryRun", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected only the query to be sent, got %d requests", calls)
+	}
+	if len(previews) != 1 || previews[0].Operation != "CreateIssue" {
+		t.Fatalf("unexpected previews: %+v", previews)
+	}
+	input, _ := previews[0].Variables["input"].(map[string]interface{})
+	if input["teamId"] != "t1" {
+		t.Fatalf("preview lost variables: %+v", previews[0].Variables)
+	}
+	if previews[0].Query[:len("mutation CreateIssue")] != "mutation CreateIssue" {
+		t.Fatalf("query not dedented: %q", previews[0].Query)
+	}
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors for the failure classes callers need to tell apart.
+// Match them with errors.Is on any error returned by Execute.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrUnauthenticated = errors.New("not authenticated")
+	ErrForbidden       = errors.New("forbidden")
+	ErrRateLimited     = errors.New("rate limited")
+	ErrValidation      = errors.New("invalid input")
+)
+
+// GraphQLErrorExtensions carries Linear's machine-readable error details
+type GraphQLErrorExtensions struct {
+	Code                   string `json:"code,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	UserPresentableMessage string `json:"userPresentableMessage,omitempty"`
+	UserError              bool   `json:"userError,omitempty"`
+	StatusCode             int    `json:"statusCode,omitempty"`
+}
+
+// Error implements the error interface
+func (e GraphQLError) Error() string {
+	if e.Extensions != nil && e.Extensions.UserPresentableMessage != "" && e.Extensions.UserPresentableMessage != e.Message {
+		return fmt.Sprintf("%s (%s)", e.Message, e.Extensions.UserPresentableMessage)
+	}
+	return e.Message
+}
+
+// Is reports whether the error belongs to the class of the given sentinel
+func (e GraphQLError) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
+
+// Kind classifies the error as one of the sentinel errors from its
+// extensions code and type, or returns nil if they are unknown. The message
+// text is never consulted.
+func (e GraphQLError) Kind() error {
+	code, typ := "", ""
+	if e.Extensions != nil {
+		code = strings.ToUpper(e.Extensions.Code)
+		typ = strings.ToLower(e.Extensions.Type)
+	}
+
+	switch {
+	case code == "RATELIMITED" || typ == "ratelimited":
+		return ErrRateLimited
+	case code == "AUTHENTICATION_ERROR" || code == "UNAUTHENTICATED" || typ == "authentication error":
+		return ErrUnauthenticated
+	case code == "FORBIDDEN" || typ == "forbidden":
+		return ErrForbidden
+	case code == "NOT_FOUND" || code == "ENTITY_NOT_FOUND" || typ == "not found" || typ == "entity not found":
+		return ErrNotFound
+	case code == "INVALID_INPUT" || code == "INPUT_ERROR" || code == "BAD_USER_INPUT" ||
+		code == "GRAPHQL_VALIDATION_FAILED" || code == "GRAPHQL_PARSE_FAILED" || typ == "invalid input":
+		return ErrValidation
+	}
+	return nil
+}
+
+// NotFoundEntity returns the type of entity, such as "Project", that a
+// not-found error refers to, or "" when it does not say. Linear reports
+// missing references as "Entity not found: <Type>".
+func NotFoundEntity(err error) string {
+	var gqlErrs GraphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		return ""
+	}
+	for _, gqlErr := range gqlErrs {
+		if gqlErr.Kind() != ErrNotFound {
+			continue
+		}
+		if _, entity, ok := strings.Cut(gqlErr.Message, "Entity not found:"); ok {
+			return strings.TrimSpace(entity)
+		}
+	}
+	return ""
+}
+
+// GraphQLErrors is the error returned when a response contains GraphQL errors
+type GraphQLErrors []GraphQLError
+
+// Error implements the error interface
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, gqlErr := range e {
+		msgs[i] = gqlErr.Error()
+	}
+	return "GraphQL errors: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes the individual errors to errors.Is and errors.As
+func (e GraphQLErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, gqlErr := range e {
+		errs[i] = gqlErr
+	}
+	return errs
+}
+
+// HTTPError is returned when the API responds with a non-200 status
+type HTTPError struct {
+	StatusCode int
+	Body       string
+	// Errors holds any GraphQL errors decoded from the response body
+	Errors GraphQLErrors
+}
+
+// Error implements the error interface
+func (e *HTTPError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Errors.Error())
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Is maps the HTTP status to a sentinel error
+func (e *HTTPError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthenticated
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return false
+}
+
+// Unwrap exposes decoded GraphQL errors to errors.Is and errors.As
+func (e *HTTPError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors
+}
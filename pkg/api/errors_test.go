@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGraphQLErrorKind(t *testing.T) {
+	cases := []struct {
+		name string
+		err  GraphQLError
+		want error
+	}{
+		{"rate limited", GraphQLError{Message: "Rate limit exceeded", Extensions: &GraphQLErrorExtensions{Code: "RATELIMITED"}}, ErrRateLimited},
+		{"authentication", GraphQLError{Message: "Authentication required", Extensions: &GraphQLErrorExtensions{Code: "AUTHENTICATION_ERROR"}}, ErrUnauthenticated},
+		{"forbidden", GraphQLError{Message: "Forbidden", Extensions: &GraphQLErrorExtensions{Type: "forbidden"}}, ErrForbidden},
+		{"not found", GraphQLError{Message: "Entity not found: Issue", Extensions: &GraphQLErrorExtensions{Code: "NOT_FOUND"}}, ErrNotFound},
+		{"not found without code", GraphQLError{Message: "Entity not found: Issue"}, nil},
+		{"validation", GraphQLError{Message: "Argument Validation Error", Extensions: &GraphQLErrorExtensions{Code: "INVALID_INPUT"}}, ErrValidation},
+		{"unknown", GraphQLError{Message: "boom"}, nil},
+	}
+	for _, c := range cases {
+		if got := c.err.Kind(); got != c.want {
+			t.Errorf("%s: Kind() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestExecuteReturnsTypedGraphQLErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errors": []any{map[string]any{
+				"message": "Entity not found: Issue",
+				"extensions": map[string]any{
+					"code":                   "NOT_FOUND",
+					"type":                   "entity not found",
+					"userPresentableMessage": "Could not find referenced Issue.",
+				},
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	err := c.Execute(context.Background(), "query { issue(id: \"X\") { id } }", nil, nil)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	var gqlErr GraphQLError
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("expected GraphQLError in chain, got %T", err)
+	}
+	if gqlErr.Extensions == nil || gqlErr.Extensions.UserPresentableMessage != "Could not find referenced Issue." {
+		t.Fatalf("extensions not decoded: %+v", gqlErr.Extensions)
+	}
+}
+
+func TestExecuteMapsHTTPStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("unauthorized"))
+	}))
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "test")
+	err := c.Execute(context.Background(), "query { viewer { id } }", nil, nil)
+	if !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("expected ErrUnauthenticated, got %v", err)
+	}
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected HTTPError with status 401, got %v", err)
+	}
+}
+
+func TestNotFoundEntity(t *testing.T) {
+	notFound := &GraphQLErrorExtensions{Code: "NOT_FOUND"}
+	project := GraphQLErrors{{Message: "Entity not found: Project", Extensions: notFound}}
+	if got := NotFoundEntity(fmt.Errorf("create issue: %w", project)); got != "Project" {
+		t.Errorf("NotFoundEntity = %q, want Project", got)
+	}
+	wrapped := &HTTPError{StatusCode: 400, Errors: GraphQLErrors{{Message: "Argument Validation Error"}, {Message: "Entity not found: User", Extensions: notFound}}}
+	if got := NotFoundEntity(wrapped); got != "User" {
+		t.Errorf("NotFoundEntity over HTTPError = %q, want User", got)
+	}
+	if got := NotFoundEntity(fmt.Errorf("project %w", ErrNotFound)); got != "" {
+		t.Errorf("NotFoundEntity(sentinel) = %q, want empty", got)
+	}
+	if got := NotFoundEntity(GraphQLErrors{{Message: "Entity not found: Project"}}); got != "" {
+		t.Errorf("NotFoundEntity without a not-found code = %q, want empty", got)
+	}
+}
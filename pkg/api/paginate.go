@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"iter"
+)
+
+const (
+	// DefaultPageSize is the number of nodes requested per page
+	DefaultPageSize = 50
+	// MaxPageSize is the largest page Linear accepts for `first`
+	MaxPageSize = 250
+)
+
+// PageFunc fetches a single page of nodes starting after the given cursor
+type PageFunc[T any] func(ctx context.Context, first int, after string) ([]T, PageInfo, error)
+
+// Page is one page of nodes yielded by Pages
+type Page[T any] struct {
+	Nodes    []T
+	PageInfo PageInfo
+}
+
+// Pages streams pages from fetch, following PageInfo.EndCursor until the
+// connection is exhausted or limit nodes have been yielded. A limit of zero
+// or less means no cap. The last page is trimmed so the cap is never exceeded.
+func Pages[T any](ctx context.Context, pageSize, limit int, fetch PageFunc[T]) iter.Seq2[Page[T], error] {
+	pageSize = clampPageSize(pageSize)
+
+	return func(yield func(Page[T], error) bool) {
+		after := ""
+		seen := 0
+		for {
+			first := pageSize
+			if limit > 0 && limit-seen < first {
+				first = limit - seen
+			}
+
+			nodes, info, err := fetch(ctx, first, after)
+			if err != nil {
+				yield(Page[T]{}, err)
+				return
+			}
+
+			truncated := false
+			if limit > 0 && seen+len(nodes) > limit {
+				nodes = nodes[:limit-seen]
+				truncated = true
+			}
+			seen += len(nodes)
+
+			if truncated {
+				info.HasNextPage = true
+			}
+			if !yield(Page[T]{Nodes: nodes, PageInfo: info}, nil) {
+				return
+			}
+
+			if truncated || !info.HasNextPage || (limit > 0 && seen >= limit) {
+				return
+			}
+			// Guard against servers that report more pages without advancing the cursor
+			if info.EndCursor == "" || info.EndCursor == after || len(nodes) == 0 {
+				return
+			}
+			after = info.EndCursor
+		}
+	}
+}
+
+// Collect fetches pages with Pages and returns every node along with the
+// PageInfo of the last page. HasNextPage is true when the cap cut the
+// listing short.
+func Collect[T any](ctx context.Context, pageSize, limit int, fetch PageFunc[T]) ([]T, PageInfo, error) {
+	var all []T
+	var last PageInfo
+	for page, err := range Pages(ctx, pageSize, limit, fetch) {
+		if err != nil {
+			return all, last, err
+		}
+		all = append(all, page.Nodes...)
+		last = page.PageInfo
+	}
+	return all, last, nil
+}
+
+func clampPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return pageSize
+}
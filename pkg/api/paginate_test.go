@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// fakePages serves total integers in pages, recording the requested page sizes
+func fakePages(total int, sizes *[]int) PageFunc[int] {
+	return func(ctx context.Context, first int, after string) ([]int, PageInfo, error) {
+		*sizes = append(*sizes, first)
+		start := 0
+		if after != "" {
+			if _, err := fmt.Sscanf(after, "c%d", &start); err != nil {
+				return nil, PageInfo{}, err
+			}
+		}
+		end := start + first
+		if end > total {
+			end = total
+		}
+		nodes := []int{}
+		for i := start; i < end; i++ {
+			nodes = append(nodes, i)
+		}
+		return nodes, PageInfo{HasNextPage: end < total, EndCursor: fmt.Sprintf("c%d", end)}, nil
+	}
+}
+
+func TestCollectAllPages(t *testing.T) {
+	var sizes []int
+	got, info, err := Collect(context.Background(), 10, 0, fakePages(25, &sizes))
+	if err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	if len(got) != 25 || got[24] != 24 {
+		t.Fatalf("expected 25 items, got %d", len(got))
+	}
+	if info.HasNextPage {
+		t.Fatal("expected HasNextPage=false after exhausting pages")
+	}
+	if len(sizes) != 3 {
+		t.Fatalf("expected 3 page requests, got %v", sizes)
+	}
+}
+
+func TestCollectRespectsLimit(t *testing.T) {
+	var sizes []int
+	got, info, err := Collect(context.Background(), 10, 15, fakePages(100, &sizes))
+	if err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	if len(got) != 15 {
+		t.Fatalf("expected 15 items, got %d", len(got))
+	}
+	if !info.HasNextPage {
+		t.Fatal("expected HasNextPage=true when the cap cut the listing short")
+	}
+	if len(sizes) != 2 || sizes[1] != 5 {
+		t.Fatalf("expected second page to request 5 items, got %v", sizes)
+	}
+}
+
+func TestPagesStopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	fetch := func(ctx context.Context, first int, after string) ([]int, PageInfo, error) {
+		calls++
+		if calls == 2 {
+			return nil, PageInfo{}, boom
+		}
+		return []int{1}, PageInfo{HasNextPage: true, EndCursor: "next"}, nil
+	}
+	_, _, err := Collect(context.Background(), 1, 0, fetch)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestPagesStopsWhenCursorDoesNotAdvance(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, first int, after string) ([]int, PageInfo, error) {
+		calls++
+		return []int{1}, PageInfo{HasNextPage: true, EndCursor: "same"}, nil
+	}
+	if _, _, err := Collect(context.Background(), 1, 0, fetch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected pagination to stop after the cursor repeated, got %d calls", calls)
+	}
+}
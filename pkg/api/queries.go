@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"time"
 )
 
@@ -75,6 +76,7 @@ type Issue struct {
 	Creator               *User            `json:"creator"`
 	Subscribers           *Users           `json:"subscribers"`
 	Relations             *IssueRelations  `json:"relations"`
+	InverseRelations      *IssueRelations  `json:"inverseRelations,omitempty"`
 	History               *IssueHistory    `json:"history"`
 	Reactions             []Reaction       `json:"reactions"`
 	SlackIssueComments    []SlackComment   `json:"slackIssueComments"`
@@ -178,20 +180,41 @@ type Label struct {
 	Name        string  `json:"name"`
 	Color       string  `json:"color"`
 	Description *string `json:"description"`
+	IsGroup     bool    `json:"isGroup"`
 	Parent      *Label  `json:"parent"`
+	Team        *Team   `json:"team"`
+}
+
+// IssueLabels is a page of labels from the issueLabels connection
+type IssueLabels struct {
+	Nodes    []Label  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
 }
 
 // Cycle represents a Linear cycle (sprint)
 type Cycle struct {
-	ID           string     `json:"id"`
-	Number       int        `json:"number"`
-	Name         string     `json:"name"`
-	Description  *string    `json:"description"`
-	StartsAt     string     `json:"startsAt"`
-	EndsAt       string     `json:"endsAt"`
-	Progress     float64    `json:"progress"`
-	CompletedAt  *time.Time `json:"completedAt"`
-	ScopeHistory []float64  `json:"scopeHistory"`
+	ID                         string     `json:"id"`
+	Number                     int        `json:"number"`
+	Name                       string     `json:"name"`
+	Description                *string    `json:"description"`
+	StartsAt                   string     `json:"startsAt"`
+	EndsAt                     string     `json:"endsAt"`
+	Progress                   float64    `json:"progress"`
+	CompletedAt                *time.Time `json:"completedAt"`
+	ScopeHistory               []float64  `json:"scopeHistory"`
+	CompletedScopeHistory      []float64  `json:"completedScopeHistory,omitempty"`
+	IssueCountHistory          []float64  `json:"issueCountHistory,omitempty"`
+	CompletedIssueCountHistory []float64  `json:"completedIssueCountHistory,omitempty"`
+	IsActive                   bool       `json:"isActive"`
+	IsNext                     bool       `json:"isNext"`
+	IsPrevious                 bool       `json:"isPrevious"`
+	Team                       *Team      `json:"team,omitempty"`
+}
+
+// Cycles represents a paginated list of cycles
+type Cycles struct {
+	Nodes    []Cycle  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
 }
 
 // Attachment represents a file attachment or link
@@ -241,7 +264,29 @@ func (a *Attachment) UnmarshalJSON(data []byte) error {
 
 // Attachments represents a paginated list of attachments
 type Attachments struct {
-	Nodes []Attachment `json:"nodes"`
+	Nodes    []Attachment `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+// Webhook represents a webhook subscription
+type Webhook struct {
+	ID             string     `json:"id"`
+	Label          *string    `json:"label"`
+	URL            string     `json:"url"`
+	Enabled        bool       `json:"enabled"`
+	Secret         *string    `json:"secret"`
+	ResourceTypes  []string   `json:"resourceTypes"`
+	AllPublicTeams bool       `json:"allPublicTeams"`
+	Team           *Team      `json:"team"`
+	Creator        *User      `json:"creator"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+// Webhooks represents a paginated list of webhooks
+type Webhooks struct {
+	Nodes    []Webhook `json:"nodes"`
+	PageInfo PageInfo  `json:"pageInfo"`
 }
 
 // Initiative represents a Linear initiative
@@ -269,7 +314,8 @@ type IssueRelation struct {
 }
 
 type IssueHistory struct {
-	Nodes []IssueHistoryEntry `json:"nodes"`
+	Nodes    []IssueHistoryEntry `json:"nodes"`
+	PageInfo PageInfo            `json:"pageInfo"`
 }
 
 type IssueHistoryEntry struct {
@@ -358,6 +404,7 @@ type ProjectUpdate struct {
 	UpdatedAt time.Time  `json:"updatedAt"`
 	EditedAt  *time.Time `json:"editedAt"`
 	Health    string     `json:"health"`
+	Reactions []Reaction `json:"reactions,omitempty"`
 }
 
 type Documents struct {
@@ -418,20 +465,22 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 }
 
 // GetIssues returns a list of issues with optional filtering
-func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*Issues, error) {
 	query := `
-		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean) {
+			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived) {
 				nodes {
 					id
 					identifier
 					title
 					description
 					priority
+					priorityLabel
 					estimate
 					createdAt
 					updatedAt
 					dueDate
+					archivedAt
 					url
 					state {
 						id
@@ -453,6 +502,11 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 						id
 						name
 					}
+					cycle {
+						id
+						number
+						name
+					}
 					labels {
 						nodes {
 							id
@@ -481,6 +535,9 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	if orderBy != "" {
 		variables["orderBy"] = orderBy
 	}
+	if includeArchived {
+		variables["includeArchived"] = true
+	}
 
 	var response struct {
 		Issues Issues `json:"issues"`
@@ -505,10 +562,12 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 					title
 					description
 					priority
+					priorityLabel
 					estimate
 					createdAt
 					updatedAt
 					dueDate
+					archivedAt
 					url
 					state {
 						id
@@ -530,6 +589,11 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 						id
 						name
 					}
+					cycle {
+						id
+						number
+						name
+					}
 					labels {
 						nodes {
 							id
@@ -772,6 +836,21 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 						}
 					}
 				}
+				inverseRelations {
+					nodes {
+						id
+						type
+						issue {
+							id
+							identifier
+							title
+							state {
+								name
+								type
+							}
+						}
+					}
+				}
 				history(first: 10) {
 					nodes {
 						id
@@ -817,6 +896,7 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 					id
 					emoji
 					user {
+						id
 						name
 						email
 					}
@@ -1423,11 +1503,55 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 	return &response.IssueCreate.Issue, nil
 }
 
-// GetTeam returns a single team by key
+// teamIDPattern matches team IDs, which are UUIDs, as opposed to team keys
+var teamIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// GetTeam returns a single team by key, falling back to a lookup by ID.
+// A UUID is looked up by ID directly.
 func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
+	if teamIDPattern.MatchString(key) {
+		return c.getTeamByID(ctx, key)
+	}
+
+	byKeyQuery := `
+		query TeamByKey($key: String!) {
+			teams(filter: { key: { eq: $key } }, first: 1) {
+				nodes {
+					id
+					key
+					name
+					description
+					private
+					issueCount
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key": key,
+	}
+
+	var byKey struct {
+		Teams Teams `json:"teams"`
+	}
+
+	err := c.Execute(ctx, byKeyQuery, variables, &byKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(byKey.Teams.Nodes) > 0 {
+		return &byKey.Teams.Nodes[0], nil
+	}
+	return c.getTeamByID(ctx, key)
+}
+
+// getTeamByID returns a single team by ID
+func (c *Client) getTeamByID(ctx context.Context, id string) (*Team, error) {
 	query := `
-		query Team($key: String!) {
-			team(id: $key) {
+		query Team($id: String!) {
+			team(id: $id) {
 				id
 				key
 				name
@@ -1439,7 +1563,7 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 	`
 
 	variables := map[string]interface{}{
-		"key": key,
+		"id": id,
 	}
 
 	var response struct {
@@ -1456,14 +1580,18 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 
 // Comment represents a Linear comment
 type Comment struct {
-	ID        string     `json:"id"`
-	Body      string     `json:"body"`
-	CreatedAt time.Time  `json:"createdAt"`
-	UpdatedAt time.Time  `json:"updatedAt"`
-	EditedAt  *time.Time `json:"editedAt"`
-	User      *User      `json:"user"`
-	Parent    *Comment   `json:"parent"`
-	Children  *Comments  `json:"children"`
+	ID            string     `json:"id"`
+	Body          string     `json:"body"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	EditedAt      *time.Time `json:"editedAt"`
+	ResolvedAt    *time.Time `json:"resolvedAt,omitempty"`
+	ResolvingUser *User      `json:"resolvingUser,omitempty"`
+	User          *User      `json:"user"`
+	Issue         *Issue     `json:"issue,omitempty"`
+	Reactions     []Reaction `json:"reactions,omitempty"`
+	Parent        *Comment   `json:"parent"`
+	Children      *Comments  `json:"children"`
 }
 
 // Comments represents a paginated list of comments
@@ -1645,17 +1773,7 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 		query IssueComments($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
 			issue(id: $id) {
 				comments(first: $first, after: $after, orderBy: $orderBy) {
-					nodes {
-						id
-						body
-						createdAt
-						updatedAt
-						user {
-							id
-							name
-							email
-						}
-					}
+					nodes {` + commentFields + `}
 					pageInfo {
 						hasNextPage
 						endCursor
@@ -1690,120 +1808,193 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 	return &response.Issue.Comments, nil
 }
 
-// CreateComment creates a new comment on an issue
-func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
+// GetIssueHistory gets a page of an issue's change history
+func (c *Client) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*IssueHistory, error) {
 	query := `
-		mutation CreateComment($input: CommentCreateInput!) {
-			commentCreate(input: $input) {
-				comment {
-					id
-					body
-					createdAt
-					updatedAt
-					user {
+		query IssueHistory($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				history(first: $first, after: $after) {
+					nodes {
 						id
-						name
-						email
+						createdAt
+						updatedAt
+						actor {
+							id
+							name
+							email
+						}
+						fromAssignee {
+							id
+							name
+						}
+						toAssignee {
+							id
+							name
+						}
+						fromState {
+							id
+							name
+							type
+						}
+						toState {
+							id
+							name
+							type
+						}
+						fromPriority
+						toPriority
+						fromTitle
+						toTitle
+						fromCycle {
+							id
+							number
+							name
+						}
+						toCycle {
+							id
+							number
+							name
+						}
+						fromProject {
+							id
+							name
+						}
+						toProject {
+							id
+							name
+						}
+						addedLabelIds
+						removedLabelIds
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
 					}
 				}
 			}
 		}
 	`
 
-	input := map[string]interface{}{
-		"issueId": issueID,
-		"body":    body,
-	}
-
 	variables := map[string]interface{}{
-		"input": input,
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
 	}
 
 	var response struct {
-		CommentCreate struct {
-			Comment Comment `json:"comment"`
-		} `json:"commentCreate"`
+		Issue *struct {
+			History IssueHistory `json:"history"`
+		} `json:"issue"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
 	if err != nil {
 		return nil, err
 	}
+	if response.Issue == nil {
+		return nil, fmt.Errorf("issue %s %w", issueID, ErrNotFound)
+	}
 
-	return &response.CommentCreate.Comment, nil
+	return &response.Issue.History, nil
 }
 
-// ListProjectMilestones returns milestones for a specific project
-func (c *Client) ListProjectMilestones(ctx context.Context, projectID string, includeArchived bool) (*ProjectMilestones, error) {
+// commentFields is the field selection shared by comment queries
+const commentFields = `
+	id
+	body
+	createdAt
+	updatedAt
+	editedAt
+	resolvedAt
+	resolvingUser {
+		id
+		name
+	}
+	user {
+		id
+		name
+		email
+	}
+	issue {
+		id
+		identifier
+	}
+	parent {
+		id
+	}
+	reactions {
+		id
+		emoji
+		user {
+			id
+			name
+		}
+	}
+`
+
+// GetComment gets a single comment by ID
+func (c *Client) GetComment(ctx context.Context, id string) (*Comment, error) {
 	query := `
-		query ProjectMilestones($projectId: String!, $includeArchived: Boolean) {
-			project(id: $projectId) {
-				projectMilestones(includeArchived: $includeArchived) {
-					nodes {
-						id
-						name
-						description
-						targetDate
-						status
-						progress
-						sortOrder
-						createdAt
-						updatedAt
-						archivedAt
-					}
-				}
-			}
+		query Comment($id: String!) {
+			comment(id: $id) {` + commentFields + `}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"projectId":       projectID,
-		"includeArchived": includeArchived,
+		"id": id,
 	}
 
 	var response struct {
-		Project struct {
-			ProjectMilestones ProjectMilestones `json:"projectMilestones"`
-		} `json:"project"`
+		Comment *Comment `json:"comment"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
 	if err != nil {
 		return nil, err
 	}
+	if response.Comment == nil {
+		return nil, fmt.Errorf("comment %s %w", id, ErrNotFound)
+	}
 
-	return &response.Project.ProjectMilestones, nil
+	return response.Comment, nil
 }
 
-// GetProjectMilestone returns a specific project milestone
-func (c *Client) GetProjectMilestone(ctx context.Context, milestoneID string) (*ProjectMilestone, error) {
+// CreateComment creates a new comment on an issue
+func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
+	return c.createComment(ctx, map[string]interface{}{
+		"issueId": issueID,
+		"body":    body,
+	})
+}
+
+// CreateCommentReply creates a reply in the thread started by parentID
+func (c *Client) CreateCommentReply(ctx context.Context, issueID, parentID, body string) (*Comment, error) {
+	return c.createComment(ctx, map[string]interface{}{
+		"issueId":  issueID,
+		"parentId": parentID,
+		"body":     body,
+	})
+}
+
+func (c *Client) createComment(ctx context.Context, input map[string]interface{}) (*Comment, error) {
 	query := `
-		query ProjectMilestone($id: String!) {
-			projectMilestone(id: $id) {
-				id
-				name
-				description
-				targetDate
-				status
-				progress
-				sortOrder
-				createdAt
-				updatedAt
-				archivedAt
-				project {
-					id
-					name
-				}
+		mutation CreateComment($input: CommentCreateInput!) {
+			commentCreate(input: $input) {
+				comment {` + commentFields + `}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"id": milestoneID,
+		"input": input,
 	}
 
 	var response struct {
-		ProjectMilestone ProjectMilestone `json:"projectMilestone"`
+		CommentCreate struct {
+			Comment Comment `json:"comment"`
+		} `json:"commentCreate"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1811,43 +2002,259 @@ func (c *Client) GetProjectMilestone(ctx context.Context, milestoneID string) (*
 		return nil, err
 	}
 
-	return &response.ProjectMilestone, nil
+	return &response.CommentCreate.Comment, nil
 }
 
-// CreateProjectMilestone creates a new project milestone
-func (c *Client) CreateProjectMilestone(ctx context.Context, input map[string]interface{}) (*ProjectMilestone, error) {
+// UpdateComment replaces a comment's body
+func (c *Client) UpdateComment(ctx context.Context, id string, body string) (*Comment, error) {
 	query := `
-		mutation CreateProjectMilestone($input: ProjectMilestoneCreateInput!) {
-			projectMilestoneCreate(input: $input) {
+		mutation UpdateComment($id: String!, $input: CommentUpdateInput!) {
+			commentUpdate(id: $id, input: $input) {
 				success
-				projectMilestone {
-					id
-					name
-					description
-					targetDate
-					status
-					progress
-					sortOrder
-					createdAt
-					updatedAt
-					project {
-						id
-						name
-					}
-				}
+				comment {` + commentFields + `}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"input": input,
+		"id":    id,
+		"input": map[string]interface{}{"body": body},
 	}
 
 	var response struct {
-		ProjectMilestoneCreate struct {
-			Success          bool             `json:"success"`
-			ProjectMilestone ProjectMilestone `json:"projectMilestone"`
-		} `json:"projectMilestoneCreate"`
+		CommentUpdate struct {
+			Success bool    `json:"success"`
+			Comment Comment `json:"comment"`
+		} `json:"commentUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.CommentUpdate.Success {
+		return nil, fmt.Errorf("failed to update comment")
+	}
+
+	return &response.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteComment($id: String!) {
+			commentDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.CommentDelete.Success {
+		return fmt.Errorf("failed to delete comment")
+	}
+
+	return nil
+}
+
+// ResolveComment marks the thread started by a comment as resolved
+func (c *Client) ResolveComment(ctx context.Context, id string) (*Comment, error) {
+	query := `
+		mutation ResolveComment($id: String!) {
+			commentResolve(id: $id) {
+				success
+				comment {` + commentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentResolve struct {
+			Success bool    `json:"success"`
+			Comment Comment `json:"comment"`
+		} `json:"commentResolve"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.CommentResolve.Success {
+		return nil, fmt.Errorf("failed to resolve comment")
+	}
+
+	return &response.CommentResolve.Comment, nil
+}
+
+// UnresolveComment reopens a resolved comment thread
+func (c *Client) UnresolveComment(ctx context.Context, id string) (*Comment, error) {
+	query := `
+		mutation UnresolveComment($id: String!) {
+			commentUnresolve(id: $id) {
+				success
+				comment {` + commentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentUnresolve struct {
+			Success bool    `json:"success"`
+			Comment Comment `json:"comment"`
+		} `json:"commentUnresolve"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.CommentUnresolve.Success {
+		return nil, fmt.Errorf("failed to unresolve comment")
+	}
+
+	return &response.CommentUnresolve.Comment, nil
+}
+
+// ListProjectMilestones returns milestones for a specific project
+func (c *Client) ListProjectMilestones(ctx context.Context, projectID string, includeArchived bool) (*ProjectMilestones, error) {
+	query := `
+		query ProjectMilestones($projectId: String!, $includeArchived: Boolean) {
+			project(id: $projectId) {
+				projectMilestones(includeArchived: $includeArchived) {
+					nodes {
+						id
+						name
+						description
+						targetDate
+						status
+						progress
+						sortOrder
+						createdAt
+						updatedAt
+						archivedAt
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"projectId":       projectID,
+		"includeArchived": includeArchived,
+	}
+
+	var response struct {
+		Project struct {
+			ProjectMilestones ProjectMilestones `json:"projectMilestones"`
+		} `json:"project"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Project.ProjectMilestones, nil
+}
+
+// GetProjectMilestone returns a specific project milestone
+func (c *Client) GetProjectMilestone(ctx context.Context, milestoneID string) (*ProjectMilestone, error) {
+	query := `
+		query ProjectMilestone($id: String!) {
+			projectMilestone(id: $id) {
+				id
+				name
+				description
+				targetDate
+				status
+				progress
+				sortOrder
+				createdAt
+				updatedAt
+				archivedAt
+				project {
+					id
+					name
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": milestoneID,
+	}
+
+	var response struct {
+		ProjectMilestone ProjectMilestone `json:"projectMilestone"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ProjectMilestone, nil
+}
+
+// CreateProjectMilestone creates a new project milestone
+func (c *Client) CreateProjectMilestone(ctx context.Context, input map[string]interface{}) (*ProjectMilestone, error) {
+	query := `
+		mutation CreateProjectMilestone($input: ProjectMilestoneCreateInput!) {
+			projectMilestoneCreate(input: $input) {
+				success
+				projectMilestone {
+					id
+					name
+					description
+					targetDate
+					status
+					progress
+					sortOrder
+					createdAt
+					updatedAt
+					project {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ProjectMilestoneCreate struct {
+			Success          bool             `json:"success"`
+			ProjectMilestone ProjectMilestone `json:"projectMilestone"`
+		} `json:"projectMilestoneCreate"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1994,6 +2401,14 @@ func (c *Client) GetProjectUpdate(ctx context.Context, updateID string) (*Projec
 					name
 					email
 				}
+				reactions {
+					id
+					emoji
+					user {
+						id
+						name
+					}
+				}
 			}
 		}
 	`
@@ -2054,3 +2469,974 @@ func (c *Client) CreateProjectUpdate(ctx context.Context, input map[string]inter
 
 	return &response.ProjectUpdateCreate.ProjectUpdate, nil
 }
+
+// labelFields are the label fields returned by the label queries and mutations
+const labelFields = `
+	id
+	name
+	color
+	description
+	isGroup
+	parent {
+		id
+		name
+	}
+	team {
+		id
+		key
+		name
+	}
+`
+
+// GetLabels returns issue labels matching filter
+func (c *Client) GetLabels(ctx context.Context, filter map[string]interface{}, first int, after string) (*IssueLabels, error) {
+	query := `
+		query IssueLabels($filter: IssueLabelFilter, $first: Int, $after: String) {
+			issueLabels(filter: $filter, first: $first, after: $after) {
+				nodes {` + labelFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		IssueLabels IssueLabels `json:"issueLabels"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabels, nil
+}
+
+// CreateLabel creates an issue label
+func (c *Client) CreateLabel(ctx context.Context, input map[string]interface{}) (*Label, error) {
+	query := `
+		mutation CreateLabel($input: IssueLabelCreateInput!) {
+			issueLabelCreate(input: $input) {
+				success
+				issueLabel {` + labelFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		IssueLabelCreate struct {
+			Success    bool  `json:"success"`
+			IssueLabel Label `json:"issueLabel"`
+		} `json:"issueLabelCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabelCreate.IssueLabel, nil
+}
+
+// UpdateLabel updates an issue label
+func (c *Client) UpdateLabel(ctx context.Context, id string, input map[string]interface{}) (*Label, error) {
+	query := `
+		mutation UpdateLabel($id: String!, $input: IssueLabelUpdateInput!) {
+			issueLabelUpdate(id: $id, input: $input) {
+				success
+				issueLabel {` + labelFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		IssueLabelUpdate struct {
+			Success    bool  `json:"success"`
+			IssueLabel Label `json:"issueLabel"`
+		} `json:"issueLabelUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabelUpdate.IssueLabel, nil
+}
+
+// DeleteLabel deletes an issue label
+func (c *Client) DeleteLabel(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteLabel($id: String!) {
+			issueLabelDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueLabelDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueLabelDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueLabelDelete.Success {
+		return fmt.Errorf("failed to delete label")
+	}
+
+	return nil
+}
+
+// cycleFields are the cycle fields returned by the cycle queries
+const cycleFields = `
+	id
+	number
+	name
+	description
+	startsAt
+	endsAt
+	completedAt
+	progress
+	isActive
+	isNext
+	isPrevious
+	scopeHistory
+	completedScopeHistory
+	issueCountHistory
+	completedIssueCountHistory
+	team {
+		id
+		key
+		name
+	}
+`
+
+// GetCycles returns cycles matching filter
+func (c *Client) GetCycles(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Cycles, error) {
+	query := `
+		query Cycles($filter: CycleFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			cycles(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+				nodes {` + cycleFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+	if orderBy != "" {
+		variables["orderBy"] = orderBy
+	}
+
+	var response struct {
+		Cycles Cycles `json:"cycles"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Cycles, nil
+}
+
+// GetCycle returns a single cycle by ID
+func (c *Client) GetCycle(ctx context.Context, id string) (*Cycle, error) {
+	query := `
+		query Cycle($id: String!) {
+			cycle(id: $id) {` + cycleFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Cycle *Cycle `json:"cycle"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Cycle == nil {
+		return nil, fmt.Errorf("cycle %s %w", id, ErrNotFound)
+	}
+
+	return response.Cycle, nil
+}
+
+// issueRelationFields is the field selection shared by issue relation queries
+const issueRelationFields = `
+	id
+	type
+	issue {
+		id
+		identifier
+		title
+		state {
+			name
+			type
+		}
+	}
+	relatedIssue {
+		id
+		identifier
+		title
+		state {
+			name
+			type
+		}
+	}
+`
+
+// CreateIssueRelation relates issueID to relatedIssueID. relationType is one
+// of blocks, related or duplicate, read as "issue <type> relatedIssue".
+func (c *Client) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) (*IssueRelation, error) {
+	query := `
+		mutation CreateIssueRelation($input: IssueRelationCreateInput!) {
+			issueRelationCreate(input: $input) {
+				success
+				issueRelation {` + issueRelationFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		},
+	}
+
+	var response struct {
+		IssueRelationCreate struct {
+			Success       bool          `json:"success"`
+			IssueRelation IssueRelation `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.IssueRelationCreate.Success {
+		return nil, fmt.Errorf("failed to create issue relation")
+	}
+
+	return &response.IssueRelationCreate.IssueRelation, nil
+}
+
+// DeleteIssueRelation removes an issue relation
+func (c *Client) DeleteIssueRelation(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteIssueRelation($id: String!) {
+			issueRelationDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueRelationDelete.Success {
+		return fmt.Errorf("failed to delete issue relation")
+	}
+
+	return nil
+}
+
+// GetBlockedIssues returns issues matching filter that have at least one
+// blocking relation, with the blocking issues in InverseRelations
+func (c *Client) GetBlockedIssues(ctx context.Context, filter map[string]interface{}, first int, after string) (*Issues, error) {
+	query := `
+		query BlockedIssues($filter: IssueFilter, $first: Int, $after: String) {
+			issues(filter: $filter, first: $first, after: $after) {
+				nodes {
+					id
+					identifier
+					title
+					priority
+					priorityLabel
+					createdAt
+					updatedAt
+					dueDate
+					url
+					state {
+						id
+						name
+						type
+						color
+					}
+					assignee {
+						id
+						name
+						email
+					}
+					team {
+						id
+						key
+						name
+					}
+					project {
+						id
+						name
+					}
+					cycle {
+						id
+						number
+						name
+					}
+					inverseRelations(first: 50) {
+						nodes {
+							id
+							type
+							issue {
+								id
+								identifier
+								title
+								state {
+									name
+									type
+								}
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	blocked := map[string]interface{}{
+		"hasBlockedByRelations": map[string]interface{}{"eq": true},
+	}
+	for key, value := range filter {
+		blocked[key] = value
+	}
+
+	variables := map[string]interface{}{
+		"filter": blocked,
+		"first":  first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issues Issues `json:"issues"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issues, nil
+}
+
+// GetIssueChildren returns a page of an issue's direct sub-issues. Each
+// child carries at most one of its own children, enough to tell leaves apart.
+func (c *Client) GetIssueChildren(ctx context.Context, id string, first int, after string) (*Issues, error) {
+	query := `
+		query IssueChildren($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				children(first: $first, after: $after) {
+					nodes {
+						id
+						identifier
+						title
+						priority
+						estimate
+						url
+						state {
+							id
+							name
+							type
+							color
+						}
+						assignee {
+							id
+							name
+							email
+						}
+						children(first: 1) {
+							nodes {
+								id
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue *struct {
+			Children Issues `json:"children"`
+		} `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Issue == nil {
+		return nil, fmt.Errorf("issue %s %w", id, ErrNotFound)
+	}
+
+	return &response.Issue.Children, nil
+}
+
+// ArchiveIssue archives an issue
+func (c *Client) ArchiveIssue(ctx context.Context, id string) error {
+	query := `
+		mutation ArchiveIssue($id: String!) {
+			issueArchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueArchive struct {
+			Success bool `json:"success"`
+		} `json:"issueArchive"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueArchive.Success {
+		return fmt.Errorf("failed to archive issue")
+	}
+
+	return nil
+}
+
+// UnarchiveIssue restores an archived or deleted (trashed) issue
+func (c *Client) UnarchiveIssue(ctx context.Context, id string) error {
+	query := `
+		mutation UnarchiveIssue($id: String!) {
+			issueUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"issueUnarchive"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueUnarchive.Success {
+		return fmt.Errorf("failed to unarchive issue")
+	}
+
+	return nil
+}
+
+// DeleteIssue moves an issue to the trash, from which UnarchiveIssue can
+// restore it. permanent skips the trash (admins only).
+func (c *Client) DeleteIssue(ctx context.Context, id string, permanent bool) error {
+	query := `
+		mutation DeleteIssue($id: String!, $permanentlyDelete: Boolean) {
+			issueDelete(id: $id, permanentlyDelete: $permanentlyDelete) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	if permanent {
+		variables["permanentlyDelete"] = true
+	}
+
+	var response struct {
+		IssueDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.IssueDelete.Success {
+		return fmt.Errorf("failed to delete issue")
+	}
+
+	return nil
+}
+
+// CreateReaction adds an emoji reaction. input names the emoji and one of
+// issueId, commentId or projectUpdateId.
+func (c *Client) CreateReaction(ctx context.Context, input map[string]interface{}) (*Reaction, error) {
+	query := `
+		mutation CreateReaction($input: ReactionCreateInput!) {
+			reactionCreate(input: $input) {
+				success
+				reaction {
+					id
+					emoji
+					user {
+						id
+						name
+					}
+					createdAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ReactionCreate struct {
+			Success  bool     `json:"success"`
+			Reaction Reaction `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.ReactionCreate.Success {
+		return nil, fmt.Errorf("failed to add reaction")
+	}
+
+	return &response.ReactionCreate.Reaction, nil
+}
+
+// DeleteReaction removes a reaction
+func (c *Client) DeleteReaction(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteReaction($id: String!) {
+			reactionDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ReactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"reactionDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.ReactionDelete.Success {
+		return fmt.Errorf("failed to remove reaction")
+	}
+
+	return nil
+}
+
+// attachmentFields is the field selection shared by attachment queries
+const attachmentFields = `
+	id
+	title
+	subtitle
+	url
+	metadata
+	createdAt
+	creator {
+		id
+		name
+		email
+	}
+`
+
+// GetIssueAttachments gets a page of the attachments on an issue
+func (c *Client) GetIssueAttachments(ctx context.Context, issueID string, first int, after string) (*Attachments, error) {
+	query := `
+		query IssueAttachments($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				attachments(first: $first, after: $after) {
+					nodes {` + attachmentFields + `}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue *struct {
+			Attachments Attachments `json:"attachments"`
+		} `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Issue == nil {
+		return nil, fmt.Errorf("issue %s %w", issueID, ErrNotFound)
+	}
+
+	return &response.Issue.Attachments, nil
+}
+
+// CreateAttachment attaches a URL to an issue. Creating an attachment with a
+// URL already attached to the issue updates the existing attachment.
+func (c *Client) CreateAttachment(ctx context.Context, input map[string]interface{}) (*Attachment, error) {
+	query := `
+		mutation CreateAttachment($input: AttachmentCreateInput!) {
+			attachmentCreate(input: $input) {
+				success
+				attachment {` + attachmentFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		AttachmentCreate struct {
+			Success    bool       `json:"success"`
+			Attachment Attachment `json:"attachment"`
+		} `json:"attachmentCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.AttachmentCreate.Success {
+		return nil, fmt.Errorf("failed to create attachment")
+	}
+
+	return &response.AttachmentCreate.Attachment, nil
+}
+
+// DeleteAttachment removes an attachment from its issue
+func (c *Client) DeleteAttachment(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteAttachment($id: String!) {
+			attachmentDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		AttachmentDelete struct {
+			Success bool `json:"success"`
+		} `json:"attachmentDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.AttachmentDelete.Success {
+		return fmt.Errorf("failed to delete attachment")
+	}
+
+	return nil
+}
+
+// webhookFields is the field selection shared by webhook queries
+const webhookFields = `
+	id
+	label
+	url
+	enabled
+	secret
+	resourceTypes
+	allPublicTeams
+	team {
+		id
+		key
+		name
+	}
+	creator {
+		id
+		name
+		email
+	}
+	createdAt
+	updatedAt
+`
+
+// GetWebhooks gets a page of the workspace's webhooks
+func (c *Client) GetWebhooks(ctx context.Context, first int, after string) (*Webhooks, error) {
+	query := `
+		query Webhooks($first: Int, $after: String) {
+			webhooks(first: $first, after: $after) {
+				nodes {` + webhookFields + `}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Webhooks Webhooks `json:"webhooks"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Webhooks, nil
+}
+
+// GetWebhook gets a single webhook by ID
+func (c *Client) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	query := `
+		query Webhook($id: String!) {
+			webhook(id: $id) {` + webhookFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Webhook == nil {
+		return nil, fmt.Errorf("webhook %s %w", id, ErrNotFound)
+	}
+
+	return response.Webhook, nil
+}
+
+// CreateWebhook creates a webhook
+func (c *Client) CreateWebhook(ctx context.Context, input map[string]interface{}) (*Webhook, error) {
+	query := `
+		mutation CreateWebhook($input: WebhookCreateInput!) {
+			webhookCreate(input: $input) {
+				success
+				webhook {` + webhookFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		WebhookCreate struct {
+			Success bool    `json:"success"`
+			Webhook Webhook `json:"webhook"`
+		} `json:"webhookCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.WebhookCreate.Success {
+		return nil, fmt.Errorf("failed to create webhook")
+	}
+
+	return &response.WebhookCreate.Webhook, nil
+}
+
+// UpdateWebhook updates a webhook
+func (c *Client) UpdateWebhook(ctx context.Context, id string, input map[string]interface{}) (*Webhook, error) {
+	query := `
+		mutation UpdateWebhook($id: String!, $input: WebhookUpdateInput!) {
+			webhookUpdate(id: $id, input: $input) {
+				success
+				webhook {` + webhookFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		WebhookUpdate struct {
+			Success bool    `json:"success"`
+			Webhook Webhook `json:"webhook"`
+		} `json:"webhookUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.WebhookUpdate.Success {
+		return nil, fmt.Errorf("failed to update webhook")
+	}
+
+	return &response.WebhookUpdate.Webhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteWebhook($id: String!) {
+			webhookDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		WebhookDelete struct {
+			Success bool `json:"success"`
+		} `json:"webhookDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.WebhookDelete.Success {
+		return fmt.Errorf("failed to delete webhook")
+	}
+
+	return nil
+}
@@ -85,6 +85,24 @@ func TestGetTeamFallbackByID(t *testing.T) {
 	}
 }
 
+func TestGetTeamByUUIDSkipsKeyLookup(t *testing.T) {
+	var queries []string
+	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
+		queries = append(queries, query)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"team": map[string]any{"id": "123e4567-e89b-12d3-a456-426614174000", "key": "ENG"}}})
+	})
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "Bearer test")
+	got, err := c.GetTeam(context.Background(), "123e4567-e89b-12d3-a456-426614174000")
+	if err != nil {
+		t.Fatalf("GetTeam returned error: %v", err)
+	}
+	if got.Key != "ENG" || len(queries) != 1 || strings.Contains(queries[0], "teams(") {
+		t.Fatalf("team %+v after queries %q, want one lookup by ID", got, queries)
+	}
+}
+
 func TestCreateArchiveAndGetProject(t *testing.T) {
 	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
 		switch {
@@ -127,9 +145,9 @@ func TestCreateArchiveAndGetProject(t *testing.T) {
 		t.Fatalf("unexpected project: %+v", proj)
 	}
 
-	ok, err := c.ArchiveProject(context.Background(), "p1")
-	if err != nil || !ok {
-		t.Fatalf("ArchiveProject error/ok: %v %v", err, ok)
+	archived, err := c.ArchiveProject(context.Background(), "p1")
+	if err != nil || archived == nil {
+		t.Fatalf("ArchiveProject error/project: %v %v", err, archived)
 	}
 
 	got, err := c.GetProject(context.Background(), "p1")
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// UploadFile is a pre-signed URL for uploading a file to Linear's storage
+type UploadFile struct {
+	UploadURL   string         `json:"uploadUrl"`
+	AssetURL    string         `json:"assetUrl"`
+	ContentType string         `json:"contentType"`
+	Filename    string         `json:"filename"`
+	Size        int64          `json:"size"`
+	Headers     []UploadHeader `json:"headers"`
+}
+
+// UploadHeader is a header that must be sent with the upload request
+type UploadHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RequestFileUpload asks Linear for a pre-signed URL to upload a file to
+func (c *Client) RequestFileUpload(ctx context.Context, contentType, filename string, size int64) (*UploadFile, error) {
+	query := `
+		mutation FileUpload($contentType: String!, $filename: String!, $size: Int!) {
+			fileUpload(contentType: $contentType, filename: $filename, size: $size) {
+				success
+				uploadFile {
+					uploadUrl
+					assetUrl
+					contentType
+					filename
+					size
+					headers {
+						key
+						value
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"contentType": contentType,
+		"filename":    filename,
+		"size":        size,
+	}
+
+	var response struct {
+		FileUpload struct {
+			Success    bool        `json:"success"`
+			UploadFile *UploadFile `json:"uploadFile"`
+		} `json:"fileUpload"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.FileUpload.Success || response.FileUpload.UploadFile == nil {
+		return nil, fmt.Errorf("failed to request upload URL")
+	}
+
+	return response.FileUpload.UploadFile, nil
+}
+
+// Upload stores a local file in Linear's storage and returns its asset URL,
+// which can be attached to issues or embedded in markdown
+func (c *Client) Upload(ctx context.Context, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	upload, err := c.RequestFileUpload(ctx, contentType, filepath.Base(path), int64(len(data)))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.UploadURL, bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+	for _, header := range upload.Headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+
+	// Uploads can outlast the API request timeout, so only ctx bounds them
+	uploader := &http.Client{Transport: c.httpClient.Transport}
+	resp, err := uploader.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("upload failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("upload failed: HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return upload.AssetURL, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadPutsFileToSignedURL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shot.png")
+	if err := os.WriteFile(path, []byte("png-bytes"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var request GraphQLRequest
+	var put *http.Request
+	var putBody []byte
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"fileUpload": map[string]any{
+			"success": true,
+			"uploadFile": map[string]any{
+				"uploadUrl": srv.URL + "/upload",
+				"assetUrl":  "https://uploads.linear.app/asset/shot.png",
+				"headers":   []map[string]string{{"key": "x-amz-acl", "value": "private"}},
+			},
+		}}})
+	})
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		put = r
+		putBody, _ = io.ReadAll(r.Body)
+	})
+
+	c := NewClientWithURL(srv.URL+"/graphql", "test")
+	assetURL, err := c.Upload(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+
+	if assetURL != "https://uploads.linear.app/asset/shot.png" {
+		t.Fatalf("assetURL = %q", assetURL)
+	}
+	if request.Variables["contentType"] != "image/png" || request.Variables["filename"] != "shot.png" || request.Variables["size"] != float64(9) {
+		t.Fatalf("unexpected fileUpload variables: %v", request.Variables)
+	}
+	if put == nil || put.Method != http.MethodPut || string(putBody) != "png-bytes" {
+		t.Fatalf("file was not PUT to the upload URL: %+v", put)
+	}
+	if put.Header.Get("Content-Type") != "image/png" || put.Header.Get("x-amz-acl") != "private" {
+		t.Fatalf("missing upload headers: %v", put.Header)
+	}
+}
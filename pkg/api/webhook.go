@@ -0,0 +1,129 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Errors returned when a webhook delivery fails verification
+var (
+	ErrWebhookSignature = errors.New("invalid webhook signature")
+	ErrWebhookTimestamp = errors.New("webhook timestamp outside the allowed window")
+)
+
+// WebhookPayload is the body Linear posts to a webhook
+type WebhookPayload struct {
+	Action           string                 `json:"action"`
+	Type             string                 `json:"type"`
+	CreatedAt        time.Time              `json:"createdAt"`
+	Data             json.RawMessage        `json:"data"`
+	URL              string                 `json:"url"`
+	UpdatedFrom      map[string]interface{} `json:"updatedFrom,omitempty"`
+	OrganizationID   string                 `json:"organizationId"`
+	WebhookID        string                 `json:"webhookId"`
+	WebhookTimestamp int64                  `json:"webhookTimestamp"`
+}
+
+// VerifyWebhookSignature checks the Linear-Signature header of a delivery,
+// the hex HMAC-SHA256 of the raw body keyed by the webhook's secret
+func VerifyWebhookSignature(body []byte, signature, secret string) error {
+	got := strings.ToLower(strings.TrimSpace(signature))
+	if !hmac.Equal([]byte(got), []byte(SignWebhook(body, secret))) {
+		return ErrWebhookSignature
+	}
+	return nil
+}
+
+// SignWebhook returns the Linear-Signature of body for secret
+func SignWebhook(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckTimestamp rejects deliveries sent more than tolerance away from now,
+// which guards against replayed requests
+func (p *WebhookPayload) CheckTimestamp(now time.Time, tolerance time.Duration) error {
+	sent := time.UnixMilli(p.WebhookTimestamp)
+	if diff := now.Sub(sent); p.WebhookTimestamp == 0 || diff > tolerance || diff < -tolerance {
+		return fmt.Errorf("%w: sent %s", ErrWebhookTimestamp, sent.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Entity decodes the payload's data into the matching API type, such as
+// *Issue for "Issue" events. Unknown types decode to a generic map.
+func (p *WebhookPayload) Entity() (interface{}, error) {
+	var target interface{}
+	switch p.Type {
+	case "Issue":
+		target = &Issue{}
+	case "Comment":
+		target = &Comment{}
+	case "Project":
+		target = &Project{}
+	case "ProjectUpdate":
+		target = &ProjectUpdate{}
+	case "Cycle":
+		target = &Cycle{}
+	case "IssueLabel":
+		target = &Label{}
+	case "Reaction":
+		target = &Reaction{}
+	case "Attachment":
+		target = &Attachment{}
+	default:
+		var data map[string]interface{}
+		if err := json.Unmarshal(p.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s data: %w", p.Type, err)
+		}
+		return data, nil
+	}
+
+	data, err := wrapWebhookLists(p.Data, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s data: %w", p.Type, err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, fmt.Errorf("failed to decode %s data: %w", p.Type, err)
+	}
+	return target, nil
+}
+
+// wrapWebhookLists adapts webhook data to the GraphQL shape of target.
+// Webhooks send related lists, such as an issue's labels, as plain arrays
+// where queries return connections, so those are wrapped in {"nodes": ...}.
+func wrapWebhookLists(data json.RawMessage, target interface{}) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf(target).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		raw, ok := fields[name]
+		if !ok || !strings.HasPrefix(strings.TrimSpace(string(raw)), "[") {
+			continue
+		}
+		kind := field.Type
+		if kind.Kind() == reflect.Pointer {
+			kind = kind.Elem()
+		}
+		if kind.Kind() != reflect.Struct {
+			continue
+		}
+		if _, hasNodes := kind.FieldByName("Nodes"); hasNodes {
+			fields[name] = json.RawMessage(`{"nodes":` + string(raw) + `}`)
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"action":"create","type":"Issue"}`)
+	signature := SignWebhook(body, "secret")
+
+	if err := VerifyWebhookSignature(body, signature, "secret"); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	for name, sig := range map[string]string{"wrong secret": SignWebhook(body, "other"), "missing": "", "garbage": "zz"} {
+		if err := VerifyWebhookSignature(body, sig, "secret"); !errors.Is(err, ErrWebhookSignature) {
+			t.Errorf("%s: err = %v, want ErrWebhookSignature", name, err)
+		}
+	}
+	if err := VerifyWebhookSignature(append(body, ' '), signature, "secret"); !errors.Is(err, ErrWebhookSignature) {
+		t.Errorf("modified body: err = %v, want ErrWebhookSignature", err)
+	}
+}
+
+func TestWebhookCheckTimestamp(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	payload := &WebhookPayload{WebhookTimestamp: now.Add(-30 * time.Second).UnixMilli()}
+
+	if err := payload.CheckTimestamp(now, time.Minute); err != nil {
+		t.Fatalf("recent delivery rejected: %v", err)
+	}
+	if err := payload.CheckTimestamp(now.Add(time.Minute), time.Minute); !errors.Is(err, ErrWebhookTimestamp) {
+		t.Fatalf("stale delivery: err = %v, want ErrWebhookTimestamp", err)
+	}
+	if err := (&WebhookPayload{}).CheckTimestamp(now, time.Minute); !errors.Is(err, ErrWebhookTimestamp) {
+		t.Fatalf("missing timestamp: err = %v, want ErrWebhookTimestamp", err)
+	}
+}
+
+func TestWebhookEntityWrapsLists(t *testing.T) {
+	payload := &WebhookPayload{Type: "Issue", Data: []byte(`{
+		"id": "issue-1",
+		"identifier": "ENG-1",
+		"title": "Fix login",
+		"state": {"id": "s1", "name": "Todo", "type": "unstarted"},
+		"labels": [{"id": "l1", "name": "Bug"}],
+		"labelIds": ["l1"]
+	}`)}
+
+	entity, err := payload.Entity()
+	if err != nil {
+		t.Fatalf("Entity: %v", err)
+	}
+	issue, ok := entity.(*Issue)
+	if !ok {
+		t.Fatalf("entity = %T, want *Issue", entity)
+	}
+	if issue.Identifier != "ENG-1" || issue.State == nil || issue.State.Name != "Todo" {
+		t.Fatalf("issue = %+v", issue)
+	}
+	if issue.Labels == nil || len(issue.Labels.Nodes) != 1 || issue.Labels.Nodes[0].Name != "Bug" {
+		t.Fatalf("labels = %+v, want the label array as nodes", issue.Labels)
+	}
+
+	other := &WebhookPayload{Type: "AuditEntry", Data: []byte(`{"id": "a1"}`)}
+	if entity, err := other.Entity(); err != nil || entity.(map[string]interface{})["id"] != "a1" {
+		t.Fatalf("unknown type: %v, %v", entity, err)
+	}
+}
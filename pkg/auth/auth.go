@@ -4,10 +4,15 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/dorkitude/linctl/pkg/api"
 	"github.com/fatih/color"
@@ -20,8 +25,38 @@ type User struct {
 	AvatarURL string `json:"avatarUrl,omitempty"`
 }
 
+// DefaultProfile is the profile used when none is selected
+const DefaultProfile = "default"
+
+// Profile holds the credentials for a single Linear workspace: either a
+// personal API key or an OAuth token
+type Profile struct {
+	APIKey string      `json:"api_key,omitempty"`
+	OAuth  *OAuthToken `json:"oauth,omitempty"`
+}
+
+// AuthConfig is the on-disk auth file. APIKey is the legacy single-key
+// format and is migrated into the default profile when loaded.
 type AuthConfig struct {
-	APIKey string `json:"api_key,omitempty"`
+	APIKey         string             `json:"api_key,omitempty"`
+	CurrentProfile string             `json:"current_profile,omitempty"`
+	Profiles       map[string]Profile `json:"profiles,omitempty"`
+}
+
+// ProfileInfo describes a stored profile for listing
+type ProfileInfo struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Active  bool   `json:"active"`
+}
+
+// profileOverride is set from the global --profile flag
+var profileOverride string
+
+// SetProfile selects the profile for this invocation, taking precedence
+// over LINCTL_PROFILE and the stored current profile
+func SetProfile(name string) {
+	profileOverride = strings.TrimSpace(name)
 }
 
 // getConfigPath returns the path to the auth config file
@@ -33,9 +68,9 @@ func getConfigPath() (string, error) {
 	return filepath.Join(homeDir, ".linctl-auth.json"), nil
 }
 
-// saveAuth saves authentication credentials
+// saveAuth saves authentication credentials to the active credential store
 func saveAuth(config AuthConfig) error {
-	configPath, err := getConfigPath()
+	store, err := currentStore()
 	if err != nil {
 		return err
 	}
@@ -45,20 +80,20 @@ func saveAuth(config AuthConfig) error {
 		return err
 	}
 
-	return os.WriteFile(configPath, data, 0600)
+	return store.Save(data)
 }
 
-// loadAuth loads authentication credentials
+// loadAuth loads authentication credentials from the active credential store
 func loadAuth() (*AuthConfig, error) {
-	configPath, err := getConfigPath()
+	store, err := currentStore()
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := os.ReadFile(configPath)
+	data, err := store.Load()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("not authenticated")
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, api.ErrUnauthenticated
 		}
 		return nil, err
 	}
@@ -69,63 +104,258 @@ func loadAuth() (*AuthConfig, error) {
 		return nil, err
 	}
 
+	config.migrateLegacyKey()
 	return &config, nil
 }
 
+// CredentialStoreInfo returns the name and location of the active credential store
+func CredentialStoreInfo() (name, location string, err error) {
+	store, err := currentStore()
+	if err != nil {
+		return "", "", err
+	}
+	return store.Name(), store.Location(), nil
+}
+
+// migrateLegacyKey moves a top-level api_key into the default profile
+func (c *AuthConfig) migrateLegacyKey() {
+	if c.Profiles == nil {
+		c.Profiles = make(map[string]Profile)
+	}
+	if c.APIKey != "" {
+		if _, exists := c.Profiles[DefaultProfile]; !exists {
+			c.Profiles[DefaultProfile] = Profile{APIKey: c.APIKey}
+		}
+		c.APIKey = ""
+	}
+	if _, ok := c.Profiles[c.CurrentProfile]; !ok {
+		c.CurrentProfile = c.fallbackProfile()
+	}
+}
+
+// fallbackProfile picks the profile to make current when none is set:
+// the default profile if present, otherwise the first by name
+func (c *AuthConfig) fallbackProfile() string {
+	if _, ok := c.Profiles[DefaultProfile]; ok {
+		return DefaultProfile
+	}
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// activeProfileName resolves the profile for this invocation: the --profile
+// flag, then LINCTL_PROFILE, then the stored current profile
+func activeProfileName(config *AuthConfig) string {
+	if profileOverride != "" {
+		return profileOverride
+	}
+	if env := strings.TrimSpace(os.Getenv("LINCTL_PROFILE")); env != "" {
+		return env
+	}
+	if config != nil && config.CurrentProfile != "" {
+		return config.CurrentProfile
+	}
+	return DefaultProfile
+}
+
+// ActiveProfile returns the name of the profile used for this invocation
+func ActiveProfile() string {
+	config, err := loadAuth()
+	if err != nil {
+		return activeProfileName(nil)
+	}
+	return activeProfileName(config)
+}
+
+// Environment variables that supply an API key, in order of precedence.
+// Either one overrides the auth file.
+var apiKeyEnvVars = []string{"LINCTL_API_KEY", "LINEAR_API_KEY"}
+
+// CredentialSource describes where the active credential came from
+type CredentialSource struct {
+	Kind    string `json:"kind"`   // "env" or "file"
+	Method  string `json:"method"` // "api_key" or "oauth"
+	EnvVar  string `json:"env_var,omitempty"`
+	Profile string `json:"profile,omitempty"`
+	Store   string `json:"store,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// String returns a human readable description of the source
+func (s CredentialSource) String() string {
+	if s.Kind == "env" {
+		return fmt.Sprintf("environment variable %s", s.EnvVar)
+	}
+	if s.Method == "oauth" {
+		return fmt.Sprintf("profile %s in %s (OAuth)", s.Profile, s.Path)
+	}
+	return fmt.Sprintf("profile %s in %s", s.Profile, s.Path)
+}
+
+// resolveCredential returns the active API key and where it came from
+func resolveCredential() (string, CredentialSource, error) {
+	for _, name := range apiKeyEnvVars {
+		if key := strings.TrimSpace(os.Getenv(name)); key != "" {
+			return key, CredentialSource{Kind: "env", Method: "api_key", EnvVar: name}, nil
+		}
+	}
+
+	config, err := loadAuth()
+	if err != nil {
+		return "", CredentialSource{}, err
+	}
+
+	storeName, location, _ := CredentialStoreInfo()
+	name := activeProfileName(config)
+	source := CredentialSource{Kind: "file", Method: "api_key", Profile: name, Store: storeName, Path: location}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return "", source, fmt.Errorf("profile %q not found: %w", name, api.ErrUnauthenticated)
+	}
+
+	if profile.OAuth != nil {
+		source.Method = "oauth"
+		token := profile.OAuth
+		if token.expired(time.Now()) {
+			token, err = refreshOAuthToken(context.Background(), token)
+			if err != nil {
+				return "", source, err
+			}
+			profile.OAuth = token
+			config.Profiles[name] = profile
+			if err := saveAuth(*config); err != nil {
+				return "", source, err
+			}
+		}
+		return bearer(token.AccessToken), source, nil
+	}
+
+	if profile.APIKey != "" {
+		return profile.APIKey, source, nil
+	}
+
+	return "", source, fmt.Errorf("no valid authentication found: %w", api.ErrUnauthenticated)
+}
+
 // GetAuthHeader returns the authorization header value
 func GetAuthHeader() (string, error) {
+	key, _, err := resolveCredential()
+	return key, err
+}
+
+// GetCredentialSource reports where the active credential comes from
+func GetCredentialSource() (CredentialSource, error) {
+	_, source, err := resolveCredential()
+	return source, err
+}
+
+// ListProfiles returns the stored profiles sorted by name
+func ListProfiles() ([]ProfileInfo, error) {
 	config, err := loadAuth()
 	if err != nil {
-		return "", err
+		if errors.Is(err, api.ErrUnauthenticated) {
+			return []ProfileInfo{}, nil
+		}
+		return nil, err
+	}
+
+	active := activeProfileName(config)
+	profiles := make([]ProfileInfo, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		profiles = append(profiles, ProfileInfo{
+			Name:    name,
+			Current: name == config.CurrentProfile,
+			Active:  name == active,
+		})
 	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
+
+// SwitchProfile makes name the stored current profile
+func SwitchProfile(name string) error {
+	config, err := loadAuth()
+	if err != nil {
+		if errors.Is(err, api.ErrUnauthenticated) {
+			return fmt.Errorf("profile %q: %w", name, api.ErrNotFound)
+		}
+		return err
+	}
+
+	if _, ok := config.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q: %w", name, api.ErrNotFound)
+	}
+
+	config.CurrentProfile = name
+	return saveAuth(*config)
+}
 
-	if config.APIKey != "" {
-		return config.APIKey, nil
+// saveProfile stores credentials under name, creating the auth file if needed.
+// The first profile saved becomes the current one.
+func saveProfile(name string, profile Profile) error {
+	config, err := loadAuth()
+	if err != nil {
+		if !errors.Is(err, api.ErrUnauthenticated) {
+			return err
+		}
+		config = &AuthConfig{}
+		config.migrateLegacyKey()
 	}
 
-	return "", fmt.Errorf("no valid authentication found")
+	config.Profiles[name] = profile
+	if config.CurrentProfile == "" {
+		config.CurrentProfile = name
+	}
+	return saveAuth(*config)
 }
 
+// newClient builds the API client used to validate keys; tests replace it
+var newClient = api.NewClient
+
 // Login handles the authentication flow
 func Login(plaintext, jsonOut bool) error {
 	return loginWithAPIKey(plaintext, jsonOut)
 }
 
+// LoginWithToken reads an API key from r, validates it and stores it under
+// the active profile without prompting or printing anything
+func LoginWithToken(r io.Reader) (*User, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API key: %v", err)
+	}
+	return storeAPIKey(string(data))
+}
+
 // loginWithAPIKey handles Personal API Key authentication
 func loginWithAPIKey(plaintext, jsonOut bool) error {
 	if !plaintext && !jsonOut {
 		fmt.Println("\n" + color.New(color.FgYellow).Sprint("📝 Personal API Key Authentication"))
 		fmt.Println("Get your API key from: https://linear.app/settings/api")
 
-		// Get the config path to show to the user
-		configPath, _ := getConfigPath()
-		fmt.Printf("Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
+		// Show the user where the key will be stored
+		_, location, _ := CredentialStoreInfo()
+		fmt.Printf("Your credentials will be stored in: %s (profile %s)\n",
+			color.New(color.FgCyan).Sprint(location),
+			color.New(color.FgCyan).Sprint(ActiveProfile()))
 		fmt.Print("\nEnter your Personal API Key: ")
 	}
 
 	reader := bufio.NewReader(os.Stdin)
 	apiKey, err := reader.ReadString('\n')
-	if err != nil {
+	if err != nil && !(errors.Is(err, io.EOF) && apiKey != "") {
 		return err
 	}
-	apiKey = strings.TrimSpace(apiKey)
-
-	if apiKey == "" {
-		return fmt.Errorf("API key cannot be empty")
-	}
 
-	// Test the API key
-	client := api.NewClient(apiKey)
-	user, err := client.GetViewer(context.Background())
-	if err != nil {
-		return fmt.Errorf("invalid API key: %v", err)
-	}
-
-	// Save the API key
-	config := AuthConfig{
-		APIKey: apiKey,
-	}
-	err = saveAuth(config)
+	user, err := storeAPIKey(apiKey)
 	if err != nil {
 		return err
 	}
@@ -140,6 +370,34 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	return nil
 }
 
+// storeAPIKey validates apiKey against the API and saves it under the active profile
+func storeAPIKey(apiKey string) (*User, error) {
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		return nil, fmt.Errorf("API key cannot be empty")
+	}
+
+	// Test the API key
+	client := newClient(apiKey)
+	user, err := client.GetViewer(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("invalid API key: %w", err)
+	}
+
+	// Save the API key under the active profile
+	err = saveProfile(ActiveProfile(), Profile{APIKey: apiKey})
+	if err != nil {
+		return nil, err
+	}
+
+	return &User{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		AvatarURL: user.AvatarURL,
+	}, nil
+}
+
 // GetCurrentUser returns the current authenticated user
 func GetCurrentUser() (*User, error) {
 	authHeader, err := GetAuthHeader()
@@ -147,7 +405,7 @@ func GetCurrentUser() (*User, error) {
 		return nil, err
 	}
 
-	client := api.NewClient(authHeader)
+	client := newClient(authHeader)
 	apiUser, err := client.GetViewer(context.Background())
 	if err != nil {
 		return nil, err
@@ -162,17 +420,30 @@ func GetCurrentUser() (*User, error) {
 	}, nil
 }
 
-// Logout clears stored credentials
+// Logout clears the stored credentials for the active profile. The
+// credential store is emptied once no profiles remain.
 func Logout() error {
-	configPath, err := getConfigPath()
+	store, err := currentStore()
 	if err != nil {
 		return err
 	}
 
-	err = os.Remove(configPath)
-	if err != nil && !os.IsNotExist(err) {
+	config, err := loadAuth()
+	if err != nil {
+		if errors.Is(err, api.ErrUnauthenticated) {
+			return nil
+		}
 		return err
 	}
 
-	return nil
+	name := activeProfileName(config)
+	delete(config.Profiles, name)
+	if len(config.Profiles) == 0 {
+		return store.Delete()
+	}
+
+	if config.CurrentProfile == name {
+		config.CurrentProfile = config.fallbackProfile()
+	}
+	return saveAuth(*config)
 }
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+// withAuthHome points the auth file at a temp home and resets profile selection
+func withAuthHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINCTL_PROFILE", "")
+	t.Setenv("LINCTL_API_KEY", "")
+	t.Setenv("LINEAR_API_KEY", "")
+	t.Setenv("LINCTL_CREDENTIAL_STORE", StoreFile)
+	SetProfile("")
+	t.Cleanup(func() { SetProfile("") })
+	return home
+}
+
+func writeAuthFile(t *testing.T, home, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(home, ".linctl-auth.json"), []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetAuthHeaderMigratesLegacyKey(t *testing.T) {
+	home := withAuthHome(t)
+	writeAuthFile(t, home, `{"api_key": "lin_api_legacy"}`)
+
+	key, err := GetAuthHeader()
+	if err != nil {
+		t.Fatalf("GetAuthHeader returned error: %v", err)
+	}
+	if key != "lin_api_legacy" {
+		t.Fatalf("expected legacy key, got %q", key)
+	}
+	if ActiveProfile() != DefaultProfile {
+		t.Fatalf("expected default profile, got %q", ActiveProfile())
+	}
+}
+
+func TestProfileResolutionOrder(t *testing.T) {
+	home := withAuthHome(t)
+	writeAuthFile(t, home, `{
+		"current_profile": "ours",
+		"profiles": {
+			"ours": {"api_key": "key-ours"},
+			"client": {"api_key": "key-client"},
+			"other": {"api_key": "key-other"}
+		}
+	}`)
+
+	if key, _ := GetAuthHeader(); key != "key-ours" {
+		t.Fatalf("current profile: got %q", key)
+	}
+
+	t.Setenv("LINCTL_PROFILE", "client")
+	if key, _ := GetAuthHeader(); key != "key-client" {
+		t.Fatalf("LINCTL_PROFILE: got %q", key)
+	}
+
+	SetProfile("other")
+	if key, _ := GetAuthHeader(); key != "key-other" {
+		t.Fatalf("--profile: got %q", key)
+	}
+
+	SetProfile("missing")
+	if _, err := GetAuthHeader(); !errors.Is(err, api.ErrUnauthenticated) {
+		t.Fatalf("expected ErrUnauthenticated for unknown profile, got %v", err)
+	}
+}
+
+func TestSaveSwitchAndLogoutProfiles(t *testing.T) {
+	home := withAuthHome(t)
+
+	if err := saveProfile("ours", Profile{APIKey: "key-ours"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveProfile("client", Profile{APIKey: "key-client"}); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := ListProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || profiles[0].Name != "client" || !profiles[1].Current {
+		t.Fatalf("unexpected profiles: %+v", profiles)
+	}
+
+	if err := SwitchProfile("nope"); !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound switching to unknown profile, got %v", err)
+	}
+	if err := SwitchProfile("client"); err != nil {
+		t.Fatal(err)
+	}
+	if key, _ := GetAuthHeader(); key != "key-client" {
+		t.Fatalf("after switch: got %q", key)
+	}
+
+	if err := Logout(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".linctl-auth.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config AuthConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := config.Profiles["client"]; ok || config.CurrentProfile != "ours" {
+		t.Fatalf("expected logout to drop client and fall back to ours, got %+v", config)
+	}
+
+	if err := Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".linctl-auth.json")); !os.IsNotExist(err) {
+		t.Fatalf("expected auth file removed after last profile, got %v", err)
+	}
+}
+
+func TestEnvironmentKeyTakesPrecedence(t *testing.T) {
+	home := withAuthHome(t)
+	writeAuthFile(t, home, `{"profiles": {"default": {"api_key": "key-file"}}}`)
+
+	source, err := GetCredentialSource()
+	if err != nil || source.Kind != "file" || source.Profile != DefaultProfile {
+		t.Fatalf("expected file source, got %+v (%v)", source, err)
+	}
+
+	t.Setenv("LINEAR_API_KEY", "key-linear")
+	if key, _ := GetAuthHeader(); key != "key-linear" {
+		t.Fatalf("LINEAR_API_KEY: got %q", key)
+	}
+
+	t.Setenv("LINCTL_API_KEY", "key-linctl")
+	key, err := GetAuthHeader()
+	if err != nil || key != "key-linctl" {
+		t.Fatalf("LINCTL_API_KEY: got %q (%v)", key, err)
+	}
+	if source, _ := GetCredentialSource(); source.Kind != "env" || source.EnvVar != "LINCTL_API_KEY" {
+		t.Fatalf("expected LINCTL_API_KEY source, got %+v", source)
+	}
+}
+
+func TestLoginWithTokenStoresValidatedKey(t *testing.T) {
+	withAuthHome(t)
+
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"id":"u1","name":"Ada","email":"ada@example.com"}}}`))
+	}))
+	defer server.Close()
+
+	orig := newClient
+	newClient = func(authHeader string) *api.Client { return api.NewClientWithURL(server.URL, authHeader) }
+	defer func() { newClient = orig }()
+
+	SetProfile("ci")
+	user, err := LoginWithToken(strings.NewReader("  lin_api_stdin\n"))
+	if err != nil {
+		t.Fatalf("LoginWithToken returned error: %v", err)
+	}
+	if user.Email != "ada@example.com" || gotAuth != "lin_api_stdin" {
+		t.Fatalf("unexpected user %+v or auth header %q", user, gotAuth)
+	}
+	if key, _ := GetAuthHeader(); key != "lin_api_stdin" {
+		t.Fatalf("expected stored key under ci profile, got %q", key)
+	}
+
+	if _, err := LoginWithToken(strings.NewReader("\n")); err == nil {
+		t.Fatal("expected an error for an empty key")
+	}
+}
@@ -0,0 +1,369 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+const (
+	// DefaultAuthorizeURL is Linear's OAuth authorization endpoint
+	DefaultAuthorizeURL = "https://linear.app/oauth/authorize"
+	// DefaultTokenURL is Linear's OAuth token endpoint
+	DefaultTokenURL = "https://api.linear.app/oauth/token"
+	// DefaultRedirectPort is the loopback port for the OAuth callback. The
+	// redirect URI http://127.0.0.1:<port>/callback must be registered on
+	// the OAuth application.
+	DefaultRedirectPort = 8787
+	// DefaultScopes are requested when no scopes are given
+	DefaultScopes = "read,write"
+
+	// tokenExpirySkew refreshes tokens slightly before they expire
+	tokenExpirySkew = time.Minute
+)
+
+// OAuthConfig configures the authorization-code flow
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string // optional; PKCE clients do not need one
+	AuthorizeURL string
+	TokenURL     string
+	Scopes       string
+	RedirectPort int  // 0 picks a free port
+	NoBrowser    bool // only print the authorize URL
+}
+
+// OAuthToken is a stored OAuth access token and how to refresh it
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+	ClientID     string    `json:"client_id"`
+	TokenURL     string    `json:"token_url"`
+}
+
+// expired reports whether the token needs refreshing at now
+func (t *OAuthToken) expired(now time.Time) bool {
+	return !t.Expiry.IsZero() && now.Add(tokenExpirySkew).After(t.Expiry)
+}
+
+// tokenResponse is the body returned by the token endpoint
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+// oauthHTTPClient performs token requests; tests may replace it
+var oauthHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// openURL opens the authorize URL in a browser; tests replace it
+var openURL = openBrowser
+
+// LoginWithOAuth runs the authorization-code flow with PKCE: it starts a
+// loopback callback server, sends the user to the authorize URL, exchanges
+// the returned code and stores the tokens under the active profile.
+// Instructions are written to out.
+func LoginWithOAuth(ctx context.Context, cfg OAuthConfig, out io.Writer) (*User, error) {
+	cfg = withOAuthDefaults(cfg)
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("an OAuth client ID is required (--client-id or LINCTL_OAUTH_CLIENT_ID)")
+	}
+
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", cfg.RedirectPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start callback server: %v", err)
+	}
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	codes := make(chan string, 1)
+	errs := make(chan error, 1)
+	server := &http.Server{
+		Handler:           callbackHandler(state, codes, errs),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	authorizeURL := buildAuthorizeURL(cfg, redirectURI, state, pkceChallenge(verifier))
+	fmt.Fprintf(out, "Open this URL in your browser to authorize linctl:\n\n  %s\n\n", authorizeURL)
+	if !cfg.NoBrowser {
+		if err := openURL(authorizeURL); err != nil {
+			fmt.Fprintln(out, "Could not open a browser automatically; open the URL above manually.")
+		}
+	}
+	fmt.Fprintf(out, "Waiting for the callback on %s ...\n", redirectURI)
+
+	var code string
+	select {
+	case code = <-codes:
+	case err := <-errs:
+		return nil, err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out waiting for authorization: %w", ctx.Err())
+	}
+
+	token, err := exchangeToken(ctx, cfg.TokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"code_verifier": {verifier},
+	}, cfg.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := newClient(bearer(token.AccessToken))
+	user, err := client.GetViewer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("token was issued but could not be used: %w", err)
+	}
+
+	if err := saveProfile(ActiveProfile(), Profile{OAuth: token}); err != nil {
+		return nil, err
+	}
+
+	return &User{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		AvatarURL: user.AvatarURL,
+	}, nil
+}
+
+// withOAuthDefaults fills in unset endpoints, scopes and client credentials
+func withOAuthDefaults(cfg OAuthConfig) OAuthConfig {
+	if cfg.AuthorizeURL == "" {
+		cfg.AuthorizeURL = DefaultAuthorizeURL
+	}
+	if cfg.TokenURL == "" {
+		cfg.TokenURL = DefaultTokenURL
+	}
+	if cfg.Scopes == "" {
+		cfg.Scopes = DefaultScopes
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID = os.Getenv("LINCTL_OAUTH_CLIENT_ID")
+	}
+	if cfg.ClientSecret == "" {
+		cfg.ClientSecret = os.Getenv("LINCTL_OAUTH_CLIENT_SECRET")
+	}
+	return cfg
+}
+
+// buildAuthorizeURL returns the URL the user visits to grant access
+func buildAuthorizeURL(cfg OAuthConfig, redirectURI, state, challenge string) string {
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {cfg.ClientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {cfg.Scopes},
+		"state":                 {state},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(cfg.AuthorizeURL, "?") {
+		sep = "&"
+	}
+	return cfg.AuthorizeURL + sep + params.Encode()
+}
+
+// callbackHandler receives the authorization code on /callback
+func callbackHandler(state string, codes chan<- string, errs chan<- error) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if e := query.Get("error"); e != "" {
+			msg := e
+			if desc := query.Get("error_description"); desc != "" {
+				msg = fmt.Sprintf("%s: %s", e, desc)
+			}
+			writeCallbackPage(w, http.StatusBadRequest, "Authorization failed: "+msg)
+			sendErr(errs, fmt.Errorf("authorization denied: %s", msg))
+			return
+		}
+		if query.Get("state") != state {
+			writeCallbackPage(w, http.StatusBadRequest, "Authorization failed: state mismatch")
+			sendErr(errs, fmt.Errorf("authorization failed: state mismatch"))
+			return
+		}
+		code := query.Get("code")
+		if code == "" {
+			writeCallbackPage(w, http.StatusBadRequest, "Authorization failed: missing code")
+			sendErr(errs, fmt.Errorf("authorization failed: missing code"))
+			return
+		}
+		writeCallbackPage(w, http.StatusOK, "linctl is authorized. You can close this window.")
+		select {
+		case codes <- code:
+		default:
+		}
+	})
+	return mux
+}
+
+func sendErr(errs chan<- error, err error) {
+	select {
+	case errs <- err:
+	default:
+	}
+}
+
+func writeCallbackPage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<!doctype html><html><body><p>%s</p></body></html>", html.EscapeString(message))
+}
+
+// refreshOAuthToken exchanges the refresh token for a new access token
+func refreshOAuthToken(ctx context.Context, token *OAuthToken) (*OAuthToken, error) {
+	if token.RefreshToken == "" {
+		return nil, fmt.Errorf("OAuth token expired and no refresh token is stored: %w", api.ErrUnauthenticated)
+	}
+
+	refreshed, err := exchangeToken(ctx, token.TokenURL, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {token.RefreshToken},
+		"client_id":     {token.ClientID},
+		"client_secret": {os.Getenv("LINCTL_OAUTH_CLIENT_SECRET")},
+	}, token.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh OAuth token: %w", err)
+	}
+
+	// Some servers only rotate the access token
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	return refreshed, nil
+}
+
+// exchangeToken posts form to the token endpoint and returns the stored form of the token
+func exchangeToken(ctx context.Context, tokenURL string, form url.Values, clientID string) (*OAuthToken, error) {
+	for key, values := range form {
+		if len(values) == 0 || values[0] == "" {
+			form.Del(key)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := oauthHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if resp.StatusCode != http.StatusOK || tr.Error != "" || tr.AccessToken == "" {
+		msg := tr.Error
+		if tr.ErrorDesc != "" {
+			msg = fmt.Sprintf("%s: %s", tr.Error, tr.ErrorDesc)
+		}
+		if msg == "" {
+			msg = fmt.Sprintf("status %d", resp.StatusCode)
+		}
+		err := fmt.Errorf("token endpoint error: %s", msg)
+		if tr.Error == "invalid_grant" || resp.StatusCode == http.StatusUnauthorized {
+			err = fmt.Errorf("%w: %w", err, api.ErrUnauthenticated)
+		}
+		return nil, err
+	}
+
+	token := &OAuthToken{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tr.TokenType,
+		Scope:        tr.Scope,
+		ClientID:     clientID,
+		TokenURL:     tokenURL,
+	}
+	if tr.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// bearer formats an access token as an Authorization header value
+func bearer(accessToken string) string {
+	return "Bearer " + accessToken
+}
+
+// pkceChallenge derives the S256 code challenge for verifier
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomString returns n random bytes encoded as base64url
+func randomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// openBrowser opens target with the platform's default handler
+func openBrowser(target string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", target)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", target)
+	default:
+		cmd = exec.Command("xdg-open", target)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
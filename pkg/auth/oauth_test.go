@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dorkitude/linctl/pkg/api"
+)
+
+// fakeOAuthServer serves a token endpoint and a GraphQL viewer endpoint
+func fakeOAuthServer(t *testing.T, challenge *string, grants *[]url.Values) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = r.ParseForm()
+			*grants = append(*grants, r.PostForm)
+			switch r.PostForm.Get("grant_type") {
+			case "authorization_code":
+				if pkceChallenge(r.PostForm.Get("code_verifier")) != *challenge {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"bad verifier"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"access_token":"access-1","refresh_token":"refresh-1","token_type":"Bearer","expires_in":3600,"scope":"read,write"}`))
+			case "refresh_token":
+				_, _ = w.Write([]byte(`{"access_token":"access-2","token_type":"Bearer","expires_in":3600}`))
+			}
+		default:
+			if r.Header.Get("Authorization") != "Bearer access-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"viewer":{"id":"u1","name":"Ada","email":"ada@example.com"}}}`))
+		}
+	}))
+}
+
+func TestLoginWithOAuthPKCE(t *testing.T) {
+	withAuthHome(t)
+
+	var challenge string
+	var grants []url.Values
+	server := fakeOAuthServer(t, &challenge, &grants)
+	defer server.Close()
+
+	origClient, origOpen := newClient, openURL
+	defer func() { newClient, openURL = origClient, origOpen }()
+	newClient = func(authHeader string) *api.Client { return api.NewClientWithURL(server.URL, authHeader) }
+
+	// Play the browser: approve and follow the redirect to the callback server
+	openURL = func(target string) error {
+		u, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		if q.Get("code_challenge_method") != "S256" || q.Get("client_id") != "client-123" {
+			t.Errorf("unexpected authorize params: %v", q)
+		}
+		challenge = q.Get("code_challenge")
+		go func() {
+			resp, err := http.Get(q.Get("redirect_uri") + "?code=the-code&state=" + url.QueryEscape(q.Get("state")))
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	user, err := LoginWithOAuth(ctx, OAuthConfig{
+		ClientID:     "client-123",
+		AuthorizeURL: server.URL + "/oauth/authorize",
+		TokenURL:     server.URL + "/oauth/token",
+	}, io.Discard)
+	if err != nil {
+		t.Fatalf("LoginWithOAuth returned error: %v", err)
+	}
+	if user.Email != "ada@example.com" {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+	if len(grants) != 1 || grants[0].Get("code") != "the-code" || grants[0].Has("client_secret") {
+		t.Fatalf("unexpected token request: %v", grants)
+	}
+
+	header, err := GetAuthHeader()
+	if err != nil || header != "Bearer access-1" {
+		t.Fatalf("expected stored bearer token, got %q (%v)", header, err)
+	}
+	if source, _ := GetCredentialSource(); source.Method != "oauth" {
+		t.Fatalf("expected oauth source, got %+v", source)
+	}
+}
+
+func TestGetAuthHeaderRefreshesExpiredToken(t *testing.T) {
+	home := withAuthHome(t)
+
+	var challenge string
+	var grants []url.Values
+	server := fakeOAuthServer(t, &challenge, &grants)
+	defer server.Close()
+
+	expired, _ := json.Marshal(AuthConfig{
+		CurrentProfile: DefaultProfile,
+		Profiles: map[string]Profile{DefaultProfile: {OAuth: &OAuthToken{
+			AccessToken:  "stale",
+			RefreshToken: "refresh-1",
+			Expiry:       time.Now().Add(-time.Hour),
+			ClientID:     "client-123",
+			TokenURL:     server.URL + "/oauth/token",
+		}}},
+	})
+	writeAuthFile(t, home, string(expired))
+
+	header, err := GetAuthHeader()
+	if err != nil || header != "Bearer access-2" {
+		t.Fatalf("expected refreshed token, got %q (%v)", header, err)
+	}
+	if len(grants) != 1 || grants[0].Get("refresh_token") != "refresh-1" {
+		t.Fatalf("unexpected refresh request: %v", grants)
+	}
+
+	// The refreshed token is persisted and the refresh token is kept
+	header, _ = GetAuthHeader()
+	if header != "Bearer access-2" || len(grants) != 1 {
+		t.Fatalf("expected cached refreshed token, got %q after %d grants", header, len(grants))
+	}
+	config, err := loadAuth()
+	if err != nil || config.Profiles[DefaultProfile].OAuth.RefreshToken != "refresh-1" {
+		t.Fatalf("expected refresh token to be retained, got %+v (%v)", config, err)
+	}
+}
+
+func TestCallbackRejectsStateMismatch(t *testing.T) {
+	codes := make(chan string, 1)
+	errs := make(chan error, 1)
+	rec := httptest.NewRecorder()
+	callbackHandler("expected", codes, errs).ServeHTTP(rec, httptest.NewRequest("GET", "/callback?code=x&state=forged", nil))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+	select {
+	case err := <-errs:
+		if !strings.Contains(err.Error(), "state mismatch") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	default:
+		t.Fatal("expected a state mismatch error")
+	}
+}
@@ -0,0 +1,314 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/dorkitude/linctl/pkg/api"
+	"github.com/zalando/go-keyring"
+	"golang.org/x/term"
+)
+
+// Credential store backends
+const (
+	StoreKeyring   = "keyring"
+	StoreEncrypted = "encrypted"
+	StoreFile      = "file"
+)
+
+const (
+	keyringService = "linctl"
+	keyringUser    = "credentials"
+)
+
+// StoreNames lists the available backends in order of preference
+var StoreNames = []string{StoreKeyring, StoreEncrypted, StoreFile}
+
+// CredentialStore persists the serialized auth config. Load returns an
+// error matching fs.ErrNotExist when nothing has been stored.
+type CredentialStore interface {
+	Name() string
+	Location() string
+	Load() ([]byte, error)
+	Save(data []byte) error
+	Delete() error
+}
+
+// newStore returns the backend with the given name
+func newStore(name string) (CredentialStore, error) {
+	switch name {
+	case StoreKeyring:
+		return keyringStore{}, nil
+	case StoreEncrypted:
+		path, err := getEncryptedConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		return encryptedFileStore{path: path}, nil
+	case StoreFile:
+		path, err := getConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		return fileStore{path: path}, nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q (expected %s)", name, strings.Join(StoreNames, ", "))
+	}
+}
+
+// currentStore resolves the backend: LINCTL_CREDENTIAL_STORE if set,
+// otherwise wherever credentials already exist. New credentials go to the
+// keyring when one is available and to the plaintext file otherwise.
+func currentStore() (CredentialStore, error) {
+	if env := strings.TrimSpace(os.Getenv("LINCTL_CREDENTIAL_STORE")); env != "" {
+		return newStore(env)
+	}
+
+	for _, name := range []string{StoreEncrypted, StoreFile} {
+		store, err := newStore(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(store.Location()); err == nil {
+			return store, nil
+		}
+	}
+
+	if _, err := keyring.Get(keyringService, keyringUser); err == nil || errors.Is(err, keyring.ErrNotFound) {
+		return keyringStore{}, nil
+	}
+	return newStore(StoreFile)
+}
+
+// fileStore keeps credentials as plaintext JSON, readable only by the user
+type fileStore struct {
+	path string
+}
+
+func (s fileStore) Name() string     { return StoreFile }
+func (s fileStore) Location() string { return s.path }
+
+func (s fileStore) Load() ([]byte, error) {
+	return os.ReadFile(s.path)
+}
+
+func (s fileStore) Save(data []byte) error {
+	return os.WriteFile(s.path, data, 0600)
+}
+
+func (s fileStore) Delete() error {
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// keyringStore keeps credentials in the OS keyring (Secret Service on
+// Linux, Keychain on macOS, Credential Manager on Windows)
+type keyringStore struct{}
+
+func (keyringStore) Name() string { return StoreKeyring }
+func (keyringStore) Location() string {
+	return fmt.Sprintf("system keyring (service %q)", keyringService)
+}
+
+func (keyringStore) Load() ([]byte, error) {
+	secret, err := keyring.Get(keyringService, keyringUser)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, fmt.Errorf("keyring: %w", fs.ErrNotExist)
+		}
+		return nil, fmt.Errorf("failed to read from keyring: %v", err)
+	}
+	return []byte(secret), nil
+}
+
+func (keyringStore) Save(data []byte) error {
+	if err := keyring.Set(keyringService, keyringUser, string(data)); err != nil {
+		return fmt.Errorf("failed to write to keyring: %v", err)
+	}
+	return nil
+}
+
+func (keyringStore) Delete() error {
+	err := keyring.Delete(keyringService, keyringUser)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to delete from keyring: %v", err)
+	}
+	return nil
+}
+
+// scryptWorkFactor is the age scrypt cost used when encrypting
+var scryptWorkFactor = 18
+
+// encryptedFileStore keeps credentials in an age file encrypted with a passphrase
+type encryptedFileStore struct {
+	path string
+}
+
+func (s encryptedFileStore) Name() string     { return StoreEncrypted }
+func (s encryptedFileStore) Location() string { return s.path }
+
+func (s encryptedFileStore) Load() ([]byte, error) {
+	ciphertext, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := getPassphrase(false)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := age.Decrypt(bytes.NewReader(ciphertext), identity)
+	if err != nil {
+		cachedPassphrase = ""
+		return nil, fmt.Errorf("failed to decrypt %s (wrong passphrase?): %v", s.path, err)
+	}
+	return io.ReadAll(r)
+}
+
+func (s encryptedFileStore) Save(data []byte) error {
+	_, statErr := os.Stat(s.path)
+	passphrase, err := getPassphrase(os.IsNotExist(statErr))
+	if err != nil {
+		return err
+	}
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return err
+	}
+	recipient.SetWorkFactor(scryptWorkFactor)
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipient)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, buf.Bytes(), 0600)
+}
+
+func (s encryptedFileStore) Delete() error {
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// getEncryptedConfigPath returns the path to the encrypted auth file
+func getEncryptedConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".linctl-auth.age"), nil
+}
+
+// cachedPassphrase avoids prompting more than once per invocation
+var cachedPassphrase string
+
+// getPassphrase returns the encrypted store passphrase from
+// LINCTL_PASSPHRASE or an interactive prompt. When confirm is set the
+// prompt asks twice, for creating a new file.
+func getPassphrase(confirm bool) (string, error) {
+	if env := os.Getenv("LINCTL_PASSPHRASE"); env != "" {
+		return env, nil
+	}
+	if cachedPassphrase != "" {
+		return cachedPassphrase, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("the encrypted credential store needs a passphrase: set LINCTL_PASSPHRASE or run interactively")
+	}
+
+	passphrase, err := readPassphrase(fd, "Credential store passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase cannot be empty")
+	}
+	if confirm {
+		again, err := readPassphrase(fd, "Confirm passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	cachedPassphrase = passphrase
+	return passphrase, nil
+}
+
+// readPassphrase prompts on stderr and reads a line without echo
+func readPassphrase(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	data, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// MigrateCredentials moves the stored credentials into the named backend and
+// removes them from the old one. It returns the name of the old backend and
+// the location of the new one.
+func MigrateCredentials(to string) (from, location string, err error) {
+	dst, err := newStore(to)
+	if err != nil {
+		return "", "", err
+	}
+	src, err := currentStore()
+	if err != nil {
+		return "", "", err
+	}
+	if src.Name() == dst.Name() {
+		return src.Name(), "", fmt.Errorf("credentials are already stored in the %s backend", dst.Name())
+	}
+
+	data, err := src.Load()
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return src.Name(), "", fmt.Errorf("no credentials found in the %s backend: %w", src.Name(), api.ErrUnauthenticated)
+		}
+		return src.Name(), "", err
+	}
+
+	if err := dst.Save(data); err != nil {
+		return src.Name(), "", err
+	}
+	// Only remove the old copy once the new one reads back intact
+	saved, err := dst.Load()
+	if err != nil {
+		return src.Name(), "", fmt.Errorf("failed to verify migrated credentials: %v", err)
+	}
+	if !bytes.Equal(saved, data) {
+		return src.Name(), "", fmt.Errorf("failed to verify migrated credentials: contents differ")
+	}
+
+	return src.Name(), dst.Location(), src.Delete()
+}
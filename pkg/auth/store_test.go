@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func TestMigrateCredentialsBetweenStores(t *testing.T) {
+	home := withAuthHome(t)
+	t.Setenv("LINCTL_CREDENTIAL_STORE", "")
+	t.Setenv("LINCTL_PASSPHRASE", "correct horse battery staple")
+	keyring.MockInit()
+
+	origWorkFactor := scryptWorkFactor
+	scryptWorkFactor = 10
+	defer func() { scryptWorkFactor = origWorkFactor }()
+
+	writeAuthFile(t, home, `{"profiles": {"default": {"api_key": "lin_api_secret"}}}`)
+	plainPath := filepath.Join(home, ".linctl-auth.json")
+	agePath := filepath.Join(home, ".linctl-auth.age")
+
+	from, _, err := MigrateCredentials(StoreEncrypted)
+	if err != nil || from != StoreFile {
+		t.Fatalf("file -> encrypted: from=%q err=%v", from, err)
+	}
+	if _, err := os.Stat(plainPath); !os.IsNotExist(err) {
+		t.Fatal("expected the plaintext file to be removed")
+	}
+	ciphertext, err := os.ReadFile(agePath)
+	if err != nil || bytes.Contains(ciphertext, []byte("lin_api_secret")) {
+		t.Fatalf("expected an encrypted file without the key in the clear (err=%v)", err)
+	}
+	if key, err := GetAuthHeader(); err != nil || key != "lin_api_secret" {
+		t.Fatalf("reading encrypted store: got %q (%v)", key, err)
+	}
+
+	t.Setenv("LINCTL_PASSPHRASE", "wrong")
+	if _, err := GetAuthHeader(); err == nil {
+		t.Fatal("expected decryption to fail with the wrong passphrase")
+	}
+	t.Setenv("LINCTL_PASSPHRASE", "correct horse battery staple")
+
+	if from, _, err := MigrateCredentials(StoreKeyring); err != nil || from != StoreEncrypted {
+		t.Fatalf("encrypted -> keyring: from=%q err=%v", from, err)
+	}
+	if _, err := os.Stat(agePath); !os.IsNotExist(err) {
+		t.Fatal("expected the encrypted file to be removed")
+	}
+	if name, _, _ := CredentialStoreInfo(); name != StoreKeyring {
+		t.Fatalf("expected keyring to be detected, got %q", name)
+	}
+	if key, _ := GetAuthHeader(); key != "lin_api_secret" {
+		t.Fatalf("reading keyring store: got %q", key)
+	}
+
+	if _, _, err := MigrateCredentials(StoreKeyring); err == nil {
+		t.Fatal("expected an error migrating to the current backend")
+	}
+	if _, _, err := MigrateCredentials("vault"); err == nil {
+		t.Fatal("expected an error for an unknown backend")
+	}
+
+	if from, _, err := MigrateCredentials(StoreFile); err != nil || from != StoreKeyring {
+		t.Fatalf("keyring -> file: from=%q err=%v", from, err)
+	}
+	if _, err := keyring.Get(keyringService, keyringUser); err != keyring.ErrNotFound {
+		t.Fatalf("expected keyring entry to be removed, got %v", err)
+	}
+	if key, _ := GetAuthHeader(); key != "lin_api_secret" {
+		t.Fatalf("reading file store: got %q", key)
+	}
+}
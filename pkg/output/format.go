@@ -0,0 +1,221 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+var (
+	// format is the parsed --format template, if any
+	format *template.Template
+	// fields is the --fields projection, if any
+	fields [][]string
+)
+
+// templateFuncs are available inside --format templates
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"join": func(sep string, items interface{}) string {
+		v := reflect.ValueOf(items)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return fmt.Sprint(items)
+		}
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(parts, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// SetFormat sets a Go template used to render JSON output, one execution per
+// item when the output is a list. An empty string clears it.
+func SetFormat(text string) error {
+	if text == "" {
+		format = nil
+		return nil
+	}
+	tmpl, err := template.New("format").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %v", err)
+	}
+	format = tmpl
+	return nil
+}
+
+// SetFields limits JSON output to the given dotted key paths, e.g.
+// "identifier" or "state.name". An empty list clears it.
+func SetFields(paths []string) {
+	fields = nil
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		fields = append(fields, strings.Split(path, "."))
+	}
+}
+
+// Formatting reports whether --format, --fields or a serialization
+// --output mode is in effect
+func Formatting() bool {
+	return format != nil || len(fields) > 0 || mode != ""
+}
+
+// render writes data according to the active --fields, --format and
+// --output settings. It returns false when none is set.
+func render(data interface{}) (bool, error) {
+	if !Formatting() {
+		return false, nil
+	}
+
+	if len(fields) > 0 {
+		generic, err := toGeneric(data)
+		if err != nil {
+			return true, err
+		}
+		data = project(generic)
+	}
+
+	if format == nil {
+		return true, writeMode(data)
+	}
+
+	for _, item := range items(data) {
+		var buf bytes.Buffer
+		if err := format.Execute(&buf, item); err != nil {
+			return true, fmt.Errorf("--format: %v", err)
+		}
+		out := buf.String()
+		if !strings.HasSuffix(out, "\n") {
+			out += "\n"
+		}
+		fmt.Print(out)
+	}
+	return true, nil
+}
+
+// items splits a list into its elements so templates run once per item
+func items(data interface{}) []interface{} {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return []interface{}{data}
+	}
+	out := make([]interface{}, v.Len())
+	for i := range out {
+		out[i] = v.Index(i).Interface()
+	}
+	return out
+}
+
+// toGeneric round-trips data through JSON so projection works on JSON keys
+func toGeneric(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// project keeps only the configured fields of an object, or of each object in a list
+func project(value interface{}) interface{} {
+	if list, ok := value.([]interface{}); ok {
+		out := make([]interface{}, len(list))
+		for i, item := range list {
+			out[i] = project(item)
+		}
+		return out
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	out := make(map[string]interface{})
+	for _, path := range fields {
+		copyPath(obj, out, path)
+	}
+	return out
+}
+
+// copyPath copies the value at path from src into dst, keeping its nesting.
+// Lists along the path are projected element by element.
+func copyPath(src, dst map[string]interface{}, path []string) {
+	key, ok := lookupKey(src, path[0])
+	if !ok {
+		dst[path[0]] = nil
+		return
+	}
+	value := src[key]
+	if len(path) == 1 {
+		dst[key] = value
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		child, _ := dst[key].(map[string]interface{})
+		if child == nil {
+			child = make(map[string]interface{})
+			dst[key] = child
+		}
+		copyPath(v, child, path[1:])
+	case []interface{}:
+		existing, _ := dst[key].([]interface{})
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			itemObj, ok := item.(map[string]interface{})
+			if !ok {
+				list[i] = item
+				continue
+			}
+			child, _ := indexOrNil(existing, i).(map[string]interface{})
+			if child == nil {
+				child = make(map[string]interface{})
+			}
+			copyPath(itemObj, child, path[1:])
+			list[i] = child
+		}
+		dst[key] = list
+	default:
+		dst[key] = nil
+	}
+}
+
+// lookupKey finds key in obj, falling back to a case-insensitive match
+func lookupKey(obj map[string]interface{}, key string) (string, bool) {
+	if _, ok := obj[key]; ok {
+		return key, true
+	}
+	for k := range obj {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+func indexOrNil(list []interface{}, i int) interface{} {
+	if i < len(list) {
+		return list[i]
+	}
+	return nil
+}
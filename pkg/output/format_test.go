@@ -0,0 +1,131 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+type testState struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type testIssue struct {
+	ID         string     `json:"id"`
+	Identifier string     `json:"identifier"`
+	Title      string     `json:"title"`
+	State      *testState `json:"state"`
+}
+
+var testIssues = []testIssue{
+	{ID: "1", Identifier: "ENG-1", Title: "First", State: &testState{Name: "Todo", Type: "unstarted"}},
+	{ID: "2", Identifier: "ENG-2", Title: "Second"},
+}
+
+// captureStdout returns everything fn writes to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+	w.Close()
+	out, _ := io.ReadAll(r)
+	return string(out)
+}
+
+// resetFormatting clears --format and --fields after a test
+func resetFormatting(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetFormat("")
+		SetFields(nil)
+	})
+}
+
+func TestJSONWithFormatTemplate(t *testing.T) {
+	resetFormatting(t)
+	if err := SetFormat(`{{.Identifier}} {{if .State}}{{.State.Name}}{{else}}-{{end}}`); err != nil {
+		t.Fatal(err)
+	}
+
+	got := captureStdout(t, func() { JSON(testIssues) })
+	if got != "ENG-1 Todo\nENG-2 -\n" {
+		t.Fatalf("unexpected template output: %q", got)
+	}
+
+	got = captureStdout(t, func() { JSON(&testIssues[0]) })
+	if got != "ENG-1 Todo\n" {
+		t.Fatalf("unexpected single-item output: %q", got)
+	}
+}
+
+func TestSetFormatRejectsInvalidTemplate(t *testing.T) {
+	resetFormatting(t)
+	if err := SetFormat("{{.Identifier"); err == nil {
+		t.Fatal("expected a parse error")
+	}
+}
+
+func TestJSONWithFields(t *testing.T) {
+	resetFormatting(t)
+	SetFields([]string{"identifier", "state.name"})
+
+	got := captureStdout(t, func() { JSON(testIssues) })
+	want := `[
+  {
+    "identifier": "ENG-1",
+    "state": {
+      "name": "Todo"
+    }
+  },
+  {
+    "identifier": "ENG-2",
+    "state": null
+  }
+]
+`
+	if got != want {
+		t.Fatalf("unexpected projection:\n%s", got)
+	}
+}
+
+func TestFieldsThenFormatUsesJSONKeys(t *testing.T) {
+	resetFormatting(t)
+	SetFields([]string{"identifier", "title"})
+	if err := SetFormat(`{{.identifier}}: {{.title}}`); err != nil {
+		t.Fatal(err)
+	}
+
+	got := captureStdout(t, func() { JSON(testIssues) })
+	if !strings.HasPrefix(got, "ENG-1: First\nENG-2: Second\n") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
+
+func TestProjectThroughLists(t *testing.T) {
+	resetFormatting(t)
+	SetFields([]string{"labels.nodes.name"})
+
+	data := map[string]interface{}{
+		"id": "1",
+		"labels": map[string]interface{}{"nodes": []interface{}{
+			map[string]interface{}{"id": "l1", "name": "bug"},
+			map[string]interface{}{"id": "l2", "name": "ui"},
+		}},
+	}
+	got := project(data).(map[string]interface{})
+	nodes := got["labels"].(map[string]interface{})["nodes"].([]interface{})
+	if len(nodes) != 2 || nodes[1].(map[string]interface{})["name"] != "ui" || len(nodes[1].(map[string]interface{})) != 1 {
+		t.Fatalf("unexpected projection: %v", got)
+	}
+	if _, ok := got["id"]; ok {
+		t.Fatal("expected unlisted fields to be dropped")
+	}
+}
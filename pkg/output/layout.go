@@ -0,0 +1,131 @@
+package output
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+	"golang.org/x/term"
+)
+
+const (
+	// fallbackWidth is used when the terminal width cannot be detected
+	fallbackWidth = 120
+	// minFlexWidth is the narrowest a flexible column is shrunk to
+	minFlexWidth = 10
+	// tablePadding matches the padding between rich table columns
+	tablePadding = 3
+)
+
+// ansiPattern matches SGR color escape sequences
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// TerminalWidth returns the width of the terminal on stdout, honoring
+// $COLUMNS, or a fallback when stdout is not a terminal
+func TerminalWidth() int {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return fallbackWidth
+}
+
+// DisplayWidth returns the number of terminal cells s occupies, ignoring colors
+func DisplayWidth(s string) int {
+	return runewidth.StringWidth(ansiPattern.ReplaceAllString(s, ""))
+}
+
+// Truncate shortens s to at most width terminal cells, ending with "...".
+// Color escapes are preserved and never split, and multi-byte characters
+// are never cut in half.
+func Truncate(s string, width int) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return strings.Repeat(".", width)
+	}
+
+	limit := width - 3
+	var b strings.Builder
+	used := 0
+	colored := false
+	for len(s) > 0 {
+		if loc := ansiPattern.FindStringIndex(s); loc != nil && loc[0] == 0 {
+			b.WriteString(s[:loc[1]])
+			s = s[loc[1]:]
+			colored = true
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s)
+		w := runewidth.RuneWidth(r)
+		if used+w > limit {
+			break
+		}
+		b.WriteString(s[:size])
+		used += w
+		s = s[size:]
+	}
+	b.WriteString("...")
+	if colored {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// fitColumns truncates cells in flexible columns so the table fits width.
+// The widest flexible column is shrunk first, down to minFlexWidth.
+func fitColumns(data TableData, width int) TableData {
+	if len(data.Flex) == 0 || width <= 0 {
+		return data
+	}
+
+	widths := make([]int, len(data.Headers))
+	for i, header := range data.Headers {
+		widths[i] = DisplayWidth(header)
+	}
+	for _, row := range data.Rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], DisplayWidth(cell))
+			}
+		}
+	}
+
+	total := tablePadding * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+
+	for total > width {
+		widest := -1
+		for i, w := range widths {
+			if i < len(data.Flex) && data.Flex[i] && w > minFlexWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+
+	rows := make([][]string, len(data.Rows))
+	for r, row := range data.Rows {
+		rows[r] = make([]string, len(row))
+		for i, cell := range row {
+			if i < len(data.Flex) && data.Flex[i] {
+				cell = Truncate(cell, widths[i])
+			}
+			rows[r][i] = cell
+		}
+	}
+	data.Rows = rows
+	return data
+}
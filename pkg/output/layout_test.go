@@ -0,0 +1,77 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncateIsRuneAware(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"short", 10, "short"},
+		{"Überprüfung der Zahlungen", 10, "Überprü..."},
+		{"日本語のタイトルです", 9, "日本語..."},
+		{"abc", 2, ".."},
+	}
+	for _, tt := range tests {
+		got := Truncate(tt.in, tt.width)
+		if got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+		if DisplayWidth(got) > tt.width {
+			t.Errorf("Truncate(%q, %d) is %d cells wide", tt.in, tt.width, DisplayWidth(got))
+		}
+	}
+}
+
+func TestTruncateKeepsColorEscapes(t *testing.T) {
+	colored := "\x1b[31mIn Progress\x1b[0m"
+	got := Truncate(colored, 6)
+	if !strings.HasPrefix(got, "\x1b[31mIn ") || !strings.HasSuffix(got, "...\x1b[0m") {
+		t.Fatalf("Truncate() = %q", got)
+	}
+	if DisplayWidth(got) != 6 {
+		t.Fatalf("DisplayWidth() = %d, want 6", DisplayWidth(got))
+	}
+}
+
+func TestFitColumnsShrinksFlexColumns(t *testing.T) {
+	data := TableData{
+		Headers: []string{"ID", "Title", "URL"},
+		Rows: [][]string{
+			{"ENG-1", strings.Repeat("long title ", 10), "https://linear.app/x/issue/ENG-1"},
+		},
+		Flex: []bool{false, true, false},
+	}
+
+	fitted := fitColumns(data, 60)
+	row := fitted.Rows[0]
+	if row[0] != "ENG-1" || row[2] != data.Rows[0][2] {
+		t.Fatalf("fixed columns changed: %q", row)
+	}
+	total := DisplayWidth(row[0]) + DisplayWidth(row[1]) + DisplayWidth(row[2]) + 2*tablePadding
+	if total > 60 {
+		t.Fatalf("fitted row is %d cells wide, want <= 60", total)
+	}
+	if !strings.HasSuffix(row[1], "...") {
+		t.Fatalf("title not truncated: %q", row[1])
+	}
+	if data.Rows[0][1] != strings.Repeat("long title ", 10) {
+		t.Fatal("fitColumns modified its input")
+	}
+}
+
+func TestFitColumnsKeepsMinimumWidth(t *testing.T) {
+	data := TableData{
+		Headers: []string{"Title"},
+		Rows:    [][]string{{strings.Repeat("x", 50)}},
+		Flex:    []bool{true},
+	}
+	fitted := fitColumns(data, 5)
+	if got := DisplayWidth(fitted.Rows[0][0]); got != minFlexWidth {
+		t.Fatalf("width = %d, want %d", got, minFlexWidth)
+	}
+}
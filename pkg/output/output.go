@@ -14,10 +14,27 @@ import (
 type TableData struct {
 	Headers []string
 	Rows    [][]string
+	// Flex marks columns that may be truncated to fit the terminal width
+	Flex []bool
 }
 
-// JSON outputs data as JSON
+// JSON outputs data as JSON, or through the --format template and
+// --fields projection when they are set
 func JSON(data interface{}) {
+	handled, err := render(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error formatting output: %v\n", err)
+		os.Exit(1)
+	}
+	if handled {
+		return
+	}
+	rawJSON(data)
+}
+
+// rawJSON outputs data as JSON, ignoring --format and --fields. Used for
+// status messages, which do not have the shape of command results.
+func rawJSON(data interface{}) {
 	jsonData, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
@@ -29,7 +46,7 @@ func JSON(data interface{}) {
 // Error outputs an error message
 func Error(message string, plaintext, jsonOut bool) {
 	if jsonOut {
-		JSON(map[string]interface{}{
+		rawJSON(map[string]interface{}{
 			"error": message,
 		})
 	} else if plaintext {
@@ -42,7 +59,7 @@ func Error(message string, plaintext, jsonOut bool) {
 // Success outputs a success message
 func Success(message string, plaintext, jsonOut bool) {
 	if jsonOut {
-		JSON(map[string]interface{}{
+		rawJSON(map[string]interface{}{
 			"status":  "success",
 			"message": message,
 		})
@@ -55,6 +72,19 @@ func Success(message string, plaintext, jsonOut bool) {
 
 // Table outputs data in table format
 func Table(data TableData, plaintext, jsonOut bool) {
+	if jsonOut && format == nil && len(fields) == 0 && (mode == ModeCSV || mode == ModeTSV) {
+		// Keep the table's own column order
+		comma := ','
+		if mode == ModeTSV {
+			comma = '\t'
+		}
+		if err := writeTable(data, comma); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if jsonOut {
 		// Convert table data to JSON
 		jsonData := make([]map[string]interface{}, len(data.Rows))
@@ -83,6 +113,7 @@ func Table(data TableData, plaintext, jsonOut bool) {
 	}
 
 	// Rich table output
+	data = fitColumns(data, TerminalWidth())
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader(data.Headers)
 	table.SetAutoWrapText(false)
@@ -112,8 +143,11 @@ func Table(data TableData, plaintext, jsonOut bool) {
 
 // Info outputs an informational message
 func Info(message string, plaintext, jsonOut bool) {
-	if jsonOut {
-		JSON(map[string]interface{}{
+	if Formatting() {
+		// Keep templated and projected output free of status messages
+		fmt.Fprintln(os.Stderr, message)
+	} else if jsonOut {
+		rawJSON(map[string]interface{}{
 			"info": message,
 		})
 	} else if plaintext {
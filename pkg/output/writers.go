@@ -0,0 +1,409 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output modes selectable with --output
+const (
+	ModeTable     = "table"
+	ModePlaintext = "plaintext"
+	ModeJSON      = "json"
+	ModeCSV       = "csv"
+	ModeTSV       = "tsv"
+	ModeYAML      = "yaml"
+	ModeNDJSON    = "ndjson"
+)
+
+// Modes lists the values accepted by --output
+var Modes = []string{ModeTable, ModePlaintext, ModeJSON, ModeCSV, ModeTSV, ModeYAML, ModeNDJSON}
+
+// mode is the active serialization mode for JSON output; empty means
+// indented JSON
+var mode string
+
+// SetMode selects how JSON output is serialized: csv, tsv, yaml or ndjson.
+// table, plaintext and json leave JSON output unchanged; the caller maps
+// them onto the existing output flags.
+func SetMode(name string) error {
+	switch name {
+	case "", ModeTable, ModePlaintext, ModeJSON:
+		mode = ""
+	case ModeCSV, ModeTSV, ModeYAML, ModeNDJSON:
+		mode = name
+	default:
+		return fmt.Errorf("invalid --output %q (expected %s)", name, strings.Join(Modes, ", "))
+	}
+	return nil
+}
+
+// Streaming reports whether list output can be written page by page
+func Streaming() bool {
+	return mode == ModeNDJSON
+}
+
+// writeMode serializes data in the active mode
+func writeMode(data interface{}) error {
+	switch mode {
+	case ModeCSV:
+		return writeDelimited(data, ',')
+	case ModeTSV:
+		return writeDelimited(data, '\t')
+	case ModeYAML:
+		return writeYAML(data)
+	case ModeNDJSON:
+		return writeNDJSON(data)
+	}
+	rawJSON(data)
+	return nil
+}
+
+// writeNDJSON writes each item of a list, or a single value, as one compact JSON line
+func writeNDJSON(data interface{}) error {
+	for _, item := range items(data) {
+		line, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, string(line))
+	}
+	return nil
+}
+
+// writeYAML writes data as a YAML document, keeping JSON key order
+func writeYAML(data interface{}) error {
+	value, err := toOrdered(data)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(yamlNode(value)); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// writeDelimited writes a list of objects as CSV or TSV with a header row.
+// Nested objects become dotted columns; lists are written as JSON.
+// Columns follow --fields when set, otherwise the JSON key order of the
+// rows, with an object's columns where the object's key is.
+func writeDelimited(data interface{}, comma rune) error {
+	value, err := toOrdered(data)
+	if err != nil {
+		return err
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		list = []interface{}{value}
+	}
+
+	var columns []string
+	rows := make([]map[string]interface{}, len(list))
+
+	if len(fields) > 0 {
+		for _, path := range fields {
+			columns = append(columns, strings.Join(path, "."))
+		}
+		for i, item := range list {
+			rows[i] = make(map[string]interface{})
+			for j, path := range fields {
+				rows[i][columns[j]] = lookupPath(item, path)
+			}
+		}
+		return writeRecords(columns, rows, comma)
+	}
+
+	tree := &columnNode{}
+	for i, item := range list {
+		rows[i] = make(map[string]interface{})
+		flatten("", item, rows[i])
+		tree.merge(item)
+	}
+
+	return writeRecords(tree.leaves(""), rows, comma)
+}
+
+// lookupPath returns the value at path, mapping over lists along the way
+func lookupPath(v interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return v
+	}
+	switch val := v.(type) {
+	case object:
+		for _, f := range val {
+			if strings.EqualFold(f.Key, path[0]) {
+				return lookupPath(f.Value, path[1:])
+			}
+		}
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = lookupPath(item, path)
+		}
+		return out
+	}
+	return nil
+}
+
+// writeRecords writes a header row and one record per row
+func writeRecords(columns []string, rows []map[string]interface{}, comma rune) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	w := csv.NewWriter(os.Stdout)
+	w.Comma = comma
+	if err := w.Write(columns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, col := range columns {
+			record[i] = cell(row[col])
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// writeTable writes TableData in the active delimited mode
+func writeTable(data TableData, comma rune) error {
+	rows := make([]map[string]interface{}, len(data.Rows))
+	for i, row := range data.Rows {
+		rows[i] = make(map[string]interface{})
+		for j, header := range data.Headers {
+			if j < len(row) {
+				rows[i][header] = row[j]
+			}
+		}
+	}
+	return writeRecords(data.Headers, rows, comma)
+}
+
+// flatten records the leaf values of v under dotted keys
+func flatten(prefix string, v interface{}, row map[string]interface{}) {
+	if obj, ok := v.(object); ok && len(obj) > 0 {
+		for _, f := range obj {
+			key := f.Key
+			if prefix != "" {
+				key = prefix + "." + f.Key
+			}
+			flatten(key, f.Value, row)
+		}
+		return
+	}
+	if prefix == "" {
+		prefix = "value"
+	}
+	row[prefix] = v
+}
+
+// columnNode is one key in the column layout of a delimited list. Keys are
+// merged from every row, so an object that is null in the first rows still
+// gets its columns where the object's key is rather than at the end.
+type columnNode struct {
+	key      string
+	children []*columnNode
+}
+
+// merge adds the keys of v that are not in the layout yet. A new key goes
+// right after the key that precedes it in v, which keeps struct field order
+// when omitted or null fields only show up in later rows.
+func (n *columnNode) merge(v interface{}) {
+	obj, ok := v.(object)
+	if !ok {
+		return
+	}
+	pos := -1
+	for _, f := range obj {
+		i := n.index(f.Key)
+		if i < 0 {
+			i = pos + 1
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = &columnNode{key: f.Key}
+		}
+		n.children[i].merge(f.Value)
+		pos = i
+	}
+}
+
+func (n *columnNode) index(key string) int {
+	for i, child := range n.children {
+		if child.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// leaves returns the dotted column names under n in layout order
+func (n *columnNode) leaves(prefix string) []string {
+	if len(n.children) == 0 {
+		if prefix == "" {
+			return []string{"value"}
+		}
+		return []string{prefix}
+	}
+	var columns []string
+	for _, child := range n.children {
+		key := child.key
+		if prefix != "" {
+			key = prefix + "." + child.key
+		}
+		columns = append(columns, child.leaves(key)...)
+	}
+	return columns
+}
+
+// cell formats a flattened value for a CSV field
+func cell(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case bool:
+		if val {
+			return "true"
+		}
+		return "false"
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(data)
+	}
+}
+
+// field is one key of an object decoded with its position preserved
+type field struct {
+	Key   string
+	Value interface{}
+}
+
+// object is a JSON object that keeps its key order
+type object []field
+
+// MarshalJSON writes the object with its original key order
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(f.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// toOrdered round-trips data through JSON, keeping object key order
+func toOrdered(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decodeOrdered(decoder)
+}
+
+func decodeOrdered(decoder *json.Decoder) (interface{}, error) {
+	tok, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := object{}
+		for decoder.More() {
+			keyTok, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{Key: keyTok.(string), Value: value})
+		}
+		_, err = decoder.Token()
+		return obj, err
+	case '[':
+		list := []interface{}{}
+		for decoder.More() {
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err = decoder.Token()
+		return list, err
+	}
+	return nil, fmt.Errorf("unexpected JSON delimiter %v", delim)
+}
+
+// yamlNode converts an ordered JSON value into a YAML node
+func yamlNode(v interface{}) *yaml.Node {
+	switch val := v.(type) {
+	case object:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, f := range val {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: f.Key},
+				yamlNode(f.Value))
+		}
+		return node
+	case []interface{}:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range val {
+			node.Content = append(node.Content, yamlNode(item))
+		}
+		return node
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: val}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(val.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: val.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(val)}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
@@ -0,0 +1,120 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+// withMode sets --output for a test
+func withMode(t *testing.T, name string) {
+	t.Helper()
+	if err := SetMode(name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetMode("") })
+	resetFormatting(t)
+}
+
+type quotedIssue struct {
+	Identifier string     `json:"identifier"`
+	Title      string     `json:"title"`
+	Estimate   *float64   `json:"estimate"`
+	State      *testState `json:"state"`
+	Labels     []string   `json:"labels"`
+}
+
+func TestCSVQuotesAndKeepsKeyOrder(t *testing.T) {
+	withMode(t, ModeCSV)
+	estimate := 2.5
+	data := []quotedIssue{
+		{Identifier: "ENG-1", Title: `Fix "login", again`, Labels: []string{"bug"}},
+		{Identifier: "ENG-2", Title: "Multi\nline", Estimate: &estimate, State: &testState{Name: "Done", Type: "completed"}},
+	}
+
+	got := captureStdout(t, func() { JSON(data) })
+	want := "identifier,title,estimate,state.name,state.type,labels\n" +
+		"ENG-1,\"Fix \"\"login\"\", again\",,,,\"[\"\"bug\"\"]\"\n" +
+		"ENG-2,\"Multi\nline\",2.5,Done,completed,\n"
+	if got != want {
+		t.Fatalf("unexpected CSV:\n%q\nwant\n%q", got, want)
+	}
+}
+
+type sparseIssue struct {
+	Identifier string     `json:"identifier"`
+	Priority   int        `json:"priority,omitempty"`
+	Assignee   *testState `json:"assignee"`
+	Title      string     `json:"title"`
+}
+
+func TestCSVColumnsDoNotDependOnRowOrder(t *testing.T) {
+	withMode(t, ModeCSV)
+	rows := []sparseIssue{
+		{Identifier: "ENG-1", Title: "Unassigned"},
+		{Identifier: "ENG-2", Priority: 2, Assignee: &testState{Name: "Ada", Type: "user"}, Title: "Assigned"},
+	}
+	want := "identifier,priority,assignee.name,assignee.type,title\n"
+
+	for _, data := range [][]sparseIssue{rows, {rows[1], rows[0]}} {
+		got := captureStdout(t, func() { JSON(data) })
+		if header, _, _ := strings.Cut(got, "\n"); header+"\n" != want {
+			t.Fatalf("header = %q, want %q", header, want)
+		}
+	}
+}
+
+func TestTSVWithFields(t *testing.T) {
+	withMode(t, ModeTSV)
+	SetFields([]string{"state.name", "identifier"})
+
+	got := captureStdout(t, func() { JSON(testIssues) })
+	want := "state.name\tidentifier\nTodo\tENG-1\n\tENG-2\n"
+	if got != want {
+		t.Fatalf("unexpected TSV:\n%q", got)
+	}
+}
+
+func TestTableCSVKeepsHeaderOrder(t *testing.T) {
+	withMode(t, ModeCSV)
+	got := captureStdout(t, func() {
+		Table(TableData{Headers: []string{"Name", "Email"}, Rows: [][]string{{"Ada, L.", "ada@example.com"}}}, false, true)
+	})
+	if got != "Name,Email\n\"Ada, L.\",ada@example.com\n" {
+		t.Fatalf("unexpected table CSV: %q", got)
+	}
+}
+
+func TestYAMLKeepsKeyOrder(t *testing.T) {
+	withMode(t, ModeYAML)
+	got := captureStdout(t, func() { JSON(testIssues[:1]) })
+	want := `- id: "1"
+  identifier: ENG-1
+  title: First
+  state:
+    name: Todo
+    type: unstarted
+`
+	if got != want {
+		t.Fatalf("unexpected YAML:\n%s", got)
+	}
+}
+
+func TestNDJSONWritesOneObjectPerLine(t *testing.T) {
+	withMode(t, ModeNDJSON)
+	got := captureStdout(t, func() { JSON(testIssues) })
+	want := `{"id":"1","identifier":"ENG-1","title":"First","state":{"name":"Todo","type":"unstarted"}}
+{"id":"2","identifier":"ENG-2","title":"Second","state":null}
+`
+	if got != want {
+		t.Fatalf("unexpected NDJSON:\n%s", got)
+	}
+	if !Streaming() {
+		t.Fatal("expected ndjson to stream")
+	}
+}
+
+func TestSetModeRejectsUnknown(t *testing.T) {
+	if err := SetMode("xml"); err == nil {
+		t.Fatal("expected an error for an unknown mode")
+	}
+}
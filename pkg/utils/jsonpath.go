@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SelectPath evaluates a jq-style path expression against decoded JSON data.
+// Supported syntax: "." for the whole value, ".key" and ".key.sub" for object
+// fields, "[N]" for array indexes and "[]" to iterate over array elements or
+// object values (in key order), e.g. ".issues.nodes[].state.name". Missing
+// fields yield nil, as in jq.
+func SelectPath(data interface{}, expr string) ([]interface{}, error) {
+	steps, err := parsePath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	current := []interface{}{data}
+	for _, step := range steps {
+		var next []interface{}
+		for _, value := range current {
+			results, err := step.apply(value)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, results...)
+		}
+		current = next
+	}
+	return current, nil
+}
+
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+	iterate bool
+}
+
+func (s pathStep) apply(value interface{}) ([]interface{}, error) {
+	switch {
+	case s.iterate:
+		switch v := value.(type) {
+		case []interface{}:
+			return v, nil
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			out := make([]interface{}, 0, len(v))
+			for _, key := range keys {
+				out = append(out, v[key])
+			}
+			return out, nil
+		case nil:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("cannot iterate over %T", value)
+		}
+	case s.isIndex:
+		switch v := value.(type) {
+		case []interface{}:
+			i := s.index
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return []interface{}{nil}, nil
+			}
+			return []interface{}{v[i]}, nil
+		case nil:
+			return []interface{}{nil}, nil
+		default:
+			return nil, fmt.Errorf("cannot index %T with %d", value, s.index)
+		}
+	default:
+		switch v := value.(type) {
+		case map[string]interface{}:
+			return []interface{}{v[s.key]}, nil
+		case nil:
+			return []interface{}{nil}, nil
+		default:
+			return nil, fmt.Errorf("cannot get field %q of %T", s.key, value)
+		}
+	}
+}
+
+func parsePath(expr string) ([]pathStep, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" || expr == "." {
+		return nil, nil
+	}
+	if !strings.HasPrefix(expr, ".") && !strings.HasPrefix(expr, "[") {
+		expr = "." + expr
+	}
+
+	var steps []pathStep
+	for i := 0; i < len(expr); {
+		switch expr[i] {
+		case '.':
+			i++
+			start := i
+			for i < len(expr) && expr[i] != '.' && expr[i] != '[' {
+				i++
+			}
+			if i > start {
+				steps = append(steps, pathStep{key: expr[start:i]})
+			}
+		case '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", expr)
+			}
+			inner := strings.TrimSpace(expr[i+1 : i+end])
+			switch {
+			case inner == "":
+				steps = append(steps, pathStep{iterate: true})
+			case strings.HasPrefix(inner, `"`) && strings.HasSuffix(inner, `"`) && len(inner) >= 2:
+				steps = append(steps, pathStep{key: inner[1 : len(inner)-1]})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: bad index %q", expr, inner)
+				}
+				steps = append(steps, pathStep{index: n, isIndex: true})
+			}
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("invalid path %q at position %d", expr, i)
+		}
+	}
+	return steps, nil
+}
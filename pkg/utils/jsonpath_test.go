@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSelectPath(t *testing.T) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(`{
+		"issues": {"nodes": [
+			{"identifier": "ENG-1", "state": {"name": "Todo"}},
+			{"identifier": "ENG-2", "state": {"name": "Done"}}
+		]},
+		"team": {"key": "ENG"}
+	}`), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr string
+		want []interface{}
+	}{
+		{".team.key", []interface{}{"ENG"}},
+		{"team.key", []interface{}{"ENG"}},
+		{".issues.nodes[].identifier", []interface{}{"ENG-1", "ENG-2"}},
+		{".issues.nodes[-1].state.name", []interface{}{"Done"}},
+		{`.team["key"]`, []interface{}{"ENG"}},
+		{".team[]", []interface{}{"ENG"}},
+		{".missing.field", []interface{}{nil}},
+		{".issues.nodes[5]", []interface{}{nil}},
+	}
+	for _, tt := range tests {
+		got, err := SelectPath(data, tt.expr)
+		if err != nil {
+			t.Fatalf("SelectPath(%q) returned error: %v", tt.expr, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SelectPath(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestSelectPathErrors(t *testing.T) {
+	data := map[string]interface{}{"team": "ENG"}
+	for _, expr := range []string{".team.key", ".team[0]", ".nodes[abc]", ".nodes[0"} {
+		if _, err := SelectPath(data, expr); err == nil {
+			t.Errorf("SelectPath(%q) expected an error", expr)
+		}
+	}
+}